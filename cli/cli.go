@@ -20,10 +20,23 @@ type Flags struct {
 	migrateVersion uint
 	showVersion    bool
 
-	batchSize int64
-	parallel  bool
-	force     bool
-	targetIds targetIds
+	batchSize  int64
+	parallel   bool
+	force      bool
+	skipFailed bool
+	from       int64
+	to         int64
+	height     int64
+	dry        bool
+	limit      int64
+	targetIds  targetIds
+
+	stashAccount string
+	startEra     int64
+	endEra       int64
+	format       string
+	decimals     int64
+	output       string
 }
 
 type targetIds []int64
@@ -55,7 +68,20 @@ func (c *Flags) Setup() {
 	flag.Int64Var(&c.batchSize, "batch_size", 0, "pipeline batch size")
 	flag.BoolVar(&c.parallel, "parallel", false, "should backfill be run in parallel with indexing")
 	flag.BoolVar(&c.force, "force", false, "remove existing reindexing reports")
+	flag.BoolVar(&c.skipFailed, "skip_failed", false, "record failed heights and continue indexing instead of stopping")
+	flag.Int64Var(&c.from, "from", 0, "first height of reindexed range")
+	flag.Int64Var(&c.to, "to", 0, "last height of reindexed range")
+	flag.Int64Var(&c.height, "height", 0, "height to run pipeline for")
+	flag.BoolVar(&c.dry, "dry", false, "run pipeline without persisting results")
+	flag.Int64Var(&c.limit, "limit", 0, "number of listed records")
 	flag.Var(&c.targetIds, "target_ids", "comma separated list of integers")
+
+	flag.StringVar(&c.stashAccount, "stash_account", "", "stash account of exported rewards")
+	flag.Int64Var(&c.startEra, "start_era", 0, "first era of exported rewards")
+	flag.Int64Var(&c.endEra, "end_era", 0, "last era of exported rewards")
+	flag.StringVar(&c.format, "format", "csv", "format of exported rewards (csv or ndjson)")
+	flag.Int64Var(&c.decimals, "decimals", -1, "decimals of exported amounts in DOT (default from config)")
+	flag.StringVar(&c.output, "output", "", "path of export file (default stdout)")
 }
 
 // Run executes the command line interface
@@ -75,6 +101,11 @@ func Run() {
 		panic(fmt.Errorf("error initializing config [ERR: %+v]", err))
 	}
 
+	// Exported rewards written to stdout would be mixed with log lines
+	if flags.runCommand == "rewards_export" && flags.output == "" && cfg.LogOutput == "stdout" {
+		cfg.LogOutput = "stderr"
+	}
+
 	// Initialize logger
 	if err = initLogger(cfg); err != nil {
 		panic(fmt.Errorf("error initializing logger [ERR: %+v]", err))
@@ -100,6 +131,8 @@ func startCommand(cfg *config.Config, flags Flags) error {
 		return startServer(cfg)
 	case "worker":
 		return startWorker(cfg)
+	case "indexer_config_check":
+		return runConfigCheck(cfg)
 	default:
 		return runCmd(cfg, flags)
 	}
@@ -4,12 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/figment-networks/polkadothub-indexer/config"
 	"github.com/figment-networks/polkadothub-indexer/usecase"
+	"github.com/figment-networks/polkadothub-indexer/usecase/indexing"
 	"github.com/figment-networks/polkadothub-indexer/utils/logger"
 )
 
+// runConfigCheck checks indexer config without connecting to database and proxy
+func runConfigCheck(cfg *config.Config) error {
+	indexing.NewConfigCheckCmdHandler(cfg).Handle(context.Background())
+	return nil
+}
+
 func runCmd(cfg *config.Config, flags Flags) error {
 	db, err := initPostgres(cfg)
 	if err != nil {
@@ -23,8 +33,8 @@ func runCmd(cfg *config.Config, flags Flags) error {
 	}
 	defer client.Close()
 
-	cmdHandlers := usecase.NewCmdHandlers(cfg, client, db.GetAccounts(), db.GetBlocks(), db.GetDatabase(), db.GetEvents(), db.GetReports(),
-		db.GetRewards(), db.GetSyncables(), db.GetSystemEvents(), db.GetTransactions(), db.GetValidators(),
+	cmdHandlers := usecase.NewCmdHandlers(cfg, client, db.GetAccounts(), db.GetBlocks(), db.GetDatabase(), db.GetEvents(), db.GetFailedHeights(), db.GetIdentities(), db.GetReports(),
+		db.GetRewards(), db.GetSlashes(), db.GetSyncables(), db.GetSystemEvents(), db.GetTargetRanges(), db.GetTransactions(), db.GetValidators(), db.GetWebhooks(),
 	)
 
 	logger.Info(fmt.Sprintf("executing cmd %s ...", flags.runCommand), logger.Field("app", "cli"))
@@ -34,15 +44,45 @@ func runCmd(cfg *config.Config, flags Flags) error {
 	case "status":
 		cmdHandlers.GetStatus.Handle(ctx)
 	case "indexer_start":
-		cmdHandlers.StartIndexer.Handle(ctx, flags.batchSize)
+		cmdHandlers.StartIndexer.Handle(ctx, flags.batchSize, flags.skipFailed)
+	case "indexer_stream":
+		cmdHandlers.StreamIndexer.Handle(withInterrupt(ctx), flags.skipFailed)
 	case "indexer_backfill":
 		cmdHandlers.BackfillIndexer.Handle(ctx, flags.parallel, flags.force, flags.targetIds)
+	case "indexer_reindex":
+		cmdHandlers.ReindexIndexer.Handle(ctx, flags.from, flags.to, flags.targetIds)
+	case "indexer_run_height":
+		cmdHandlers.RunHeightIndexer.Handle(ctx, flags.height, flags.targetIds, flags.dry)
+	case "indexer_retry_failed":
+		cmdHandlers.RetryFailedIndexer.Handle(ctx)
+	case "indexer_reports":
+		cmdHandlers.GetReports.Handle(ctx, flags.limit)
 	case "indexer_summarize":
 		cmdHandlers.SummarizeIndexer.Handle(ctx)
 	case "indexer_purge":
 		cmdHandlers.PurgeIndexer.Handle(ctx)
+	case "rewards_export":
+		cmdHandlers.ExportRewards.Handle(ctx, flags.stashAccount, flags.startEra, flags.endEra, flags.format, flags.decimals, flags.output)
+	case "webhooks_deliver":
+		cmdHandlers.DeliverWebhooks.Handle(ctx)
 	default:
 		return errors.New(fmt.Sprintf("command %s not found", flags.runCommand))
 	}
 	return nil
 }
+
+// withInterrupt returns context which is cancelled on SIGINT or SIGTERM
+func withInterrupt(ctx context.Context) context.Context {
+	ctx, cancel := context.WithCancel(ctx)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		sig := <-signals
+		logger.Info(fmt.Sprintf("received signal %s, stopping ...", sig), logger.Field("app", "cli"))
+		cancel()
+	}()
+
+	return ctx
+}
@@ -19,8 +19,8 @@ func startServer(cfg *config.Config) error {
 	}
 	defer db.Close()
 
-	httpHandlers := usecase.NewHttpHandlers(cfg, client, db.GetAccounts(), db.GetBlocks(), db.GetDatabase(), db.GetEvents(),
-		db.GetReports(), db.GetRewards(), db.GetSyncables(), db.GetSystemEvents(), db.GetTransactions(), db.GetValidators(),
+	httpHandlers := usecase.NewHttpHandlers(cfg, client, db.GetAccounts(), db.GetBlocks(), db.GetDatabase(), db.GetEvents(), db.GetFailedHeights(), db.GetIdentities(),
+		db.GetReports(), db.GetRewards(), db.GetSlashes(), db.GetSyncables(), db.GetSystemEvents(), db.GetTargetRanges(), db.GetTransactions(), db.GetValidators(), db.GetWebhooks(),
 	)
 
 	a, err := server.New(cfg, httpHandlers)
@@ -19,8 +19,8 @@ func startWorker(cfg *config.Config) error {
 	}
 	defer client.Close()
 
-	workerHandlers := usecase.NewWorkerHandlers(cfg, client, db.GetAccounts(), db.GetBlocks(), db.GetDatabase(), db.GetEvents(), db.GetReports(),
-		db.GetRewards(), db.GetSyncables(), db.GetSystemEvents(), db.GetTransactions(), db.GetValidators(),
+	workerHandlers := usecase.NewWorkerHandlers(cfg, client, db.GetAccounts(), db.GetBlocks(), db.GetDatabase(), db.GetEvents(), db.GetFailedHeights(), db.GetIdentities(), db.GetReports(),
+		db.GetRewards(), db.GetSlashes(), db.GetSyncables(), db.GetSystemEvents(), db.GetTargetRanges(), db.GetTransactions(), db.GetValidators(), db.GetWebhooks(),
 	)
 
 	w, err := worker.New(cfg, workerHandlers)
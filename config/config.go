@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 
 	"github.com/kelseyhightower/envconfig"
 )
@@ -12,37 +13,68 @@ import (
 const (
 	modeDevelopment = "development"
 	modeProduction  = "production"
+
+	// ChangeLevels is number of active balance and commission change system event levels
+	ChangeLevels = 3
+
+	// ChangeRatePrecision is percentage step active balance and commission change rates are rounded to before they are compared with thresholds
+	ChangeRatePrecision = 0.1
 )
 
 var (
 	errEndpointRequired            = errors.New("proxy url is required")
 	errDatabaseRequired            = errors.New("database credentials are required")
 	errIndexWorkerIntervalRequired = errors.New("index worker interval is required")
+	errMissedConsecutiveThreshold  = errors.New("missed consecutive threshold must be greater than 0")
+	errMissedNofMThreshold         = errors.New("missed n of m threshold must be greater than 0 and not greater than number of sessions")
 )
 
 // Config holds the configuration data
 type Config struct {
-	AppEnv                       string `json:"app_env" envconfig:"APP_ENV" default:"development"`
-	ProxyUrl                     string `json:"proxy_url" envconfig:"PROXY_URL"`
-	ServerAddr                   string `json:"server_addr" envconfig:"SERVER_ADDR" default:"0.0.0.0"`
-	ServerPort                   int64  `json:"server_port" envconfig:"SERVER_PORT" default:"8081"`
-	FirstBlockHeight             int64  `json:"first_block_height" envconfig:"FIRST_BLOCK_HEIGHT" default:"1"`
-	IndexWorkerInterval          string `json:"index_worker_interval" envconfig:"INDEX_WORKER_INTERVAL" default:"@every 15m"`
-	SummarizeWorkerInterval      string `json:"summarize_worker_interval" envconfig:"SUMMARIZE_WORKER_INTERVAL" default:"@every 20m"`
-	PurgeWorkerInterval          string `json:"purge_worker_interval" envconfig:"PURGE_WORKER_INTERVAL" default:"@every 1h"`
-	DefaultBatchSize             int64  `json:"default_batch_size" envconfig:"DEFAULT_BATCH_SIZE" default:"0"`
-	DatabaseDSN                  string `json:"database_dsn" envconfig:"DATABASE_DSN"`
-	Debug                        bool   `json:"debug" envconfig:"DEBUG"`
-	LogLevel                     string `json:"log_level" envconfig:"LOG_LEVEL" default:"info"`
-	LogOutput                    string `json:"log_output" envconfig:"LOG_OUTPUT" default:"stdout"`
-	RollbarAccessToken           string `json:"rollbar_access_token" envconfig:"ROLLBAR_ACCESS_TOKEN"`
-	RollbarServerRoot            string `json:"rollbar_server_root" envconfig:"ROLLBAR_SERVER_ROOT"`
-	IndexerMetricAddr            string `json:"indexer_metric_addr" envconfig:"INDEXER_METRIC_ADDR" default:":8080"`
-	ServerMetricAddr             string `json:"server_metric_addr" envconfig:"SERVER_METRIC_ADDR" default:":8090"`
-	MetricServerUrl              string `json:"metric_server_url" envconfig:"METRIC_SERVER_URL" default:"/metrics"`
-	PurgeSequencesInterval       string `json:"purge_sequences_interval" envconfig:"PURGE_SEQUENCES_INTERVAL" default:"26h"`
-	PurgeHourlySummariesInterval string `json:"purge_hourly_summaries_interval" envconfig:"PURGE_HOURLY_SUMMARIES_INTERVAL" default:"26h"`
-	IndexerConfigFile            string `json:"indexer_config_file" envconfig:"INDEXER_CONFIG_FILE" default:"indexer_config.json"`
+	AppEnv                        string    `json:"app_env" envconfig:"APP_ENV" default:"development"`
+	ProxyUrl                      string    `json:"proxy_url" envconfig:"PROXY_URL"`
+	ServerAddr                    string    `json:"server_addr" envconfig:"SERVER_ADDR" default:"0.0.0.0"`
+	ServerPort                    int64     `json:"server_port" envconfig:"SERVER_PORT" default:"8081"`
+	FirstBlockHeight              int64     `json:"first_block_height" envconfig:"FIRST_BLOCK_HEIGHT" default:"1"`
+	IndexWorkerInterval           string    `json:"index_worker_interval" envconfig:"INDEX_WORKER_INTERVAL" default:"@every 15m"`
+	SummarizeWorkerInterval       string    `json:"summarize_worker_interval" envconfig:"SUMMARIZE_WORKER_INTERVAL" default:"@every 20m"`
+	PurgeWorkerInterval           string    `json:"purge_worker_interval" envconfig:"PURGE_WORKER_INTERVAL" default:"@every 1h"`
+	WebhookWorkerInterval         string    `json:"webhook_worker_interval" envconfig:"WEBHOOK_WORKER_INTERVAL" default:"@every 10s"`
+	WebhookTimeout                string    `json:"webhook_timeout" envconfig:"WEBHOOK_TIMEOUT" default:"10s"`
+	WebhookRetryInterval          string    `json:"webhook_retry_interval" envconfig:"WEBHOOK_RETRY_INTERVAL" default:"30s"`
+	WebhookMaxAttempts            int64     `json:"webhook_max_attempts" envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"8"`
+	WebhookAllowPrivateUrls       bool      `json:"webhook_allow_private_urls" envconfig:"WEBHOOK_ALLOW_PRIVATE_URLS" default:"false"`
+	SystemEventStreamPollInterval string    `json:"system_event_stream_poll_interval" envconfig:"SYSTEM_EVENT_STREAM_POLL_INTERVAL" default:"2s"`
+	IndexWorkerStream             bool      `json:"index_worker_stream" envconfig:"INDEX_WORKER_STREAM" default:"false"`
+	StreamPollInterval            string    `json:"stream_poll_interval" envconfig:"STREAM_POLL_INTERVAL" default:"6s"`
+	StreamMaxBackoff              string    `json:"stream_max_backoff" envconfig:"STREAM_MAX_BACKOFF" default:"1m"`
+	DefaultBatchSize              int64     `json:"default_batch_size" envconfig:"DEFAULT_BATCH_SIZE" default:"0"`
+	SkipFailedHeights             bool      `json:"skip_failed_heights" envconfig:"SKIP_FAILED_HEIGHTS" default:"false"`
+	FetcherPrefetchWindow         int64     `json:"fetcher_prefetch_window" envconfig:"FETCHER_PREFETCH_WINDOW" default:"0"`
+	AccountBalanceFetchWorkers    int64     `json:"account_balance_fetch_workers" envconfig:"ACCOUNT_BALANCE_FETCH_WORKERS" default:"10"`
+	IdentityCacheTTL              string    `json:"identity_cache_ttl" envconfig:"IDENTITY_CACHE_TTL" default:"24h"`
+	SlashDeferDuration            int64     `json:"slash_defer_duration" envconfig:"SLASH_DEFER_DURATION" default:"28"`
+	ChangeFeedDir                 string    `json:"change_feed_dir" envconfig:"CHANGE_FEED_DIR"`
+	ChangeFeedMaxFileSize         int64     `json:"change_feed_max_file_size" envconfig:"CHANGE_FEED_MAX_FILE_SIZE" default:"104857600"`
+	ReportCheckpointInterval      string    `json:"report_checkpoint_interval" envconfig:"REPORT_CHECKPOINT_INTERVAL" default:"30s"`
+	RewardsExportDecimals         int64     `json:"rewards_export_decimals" envconfig:"REWARDS_EXPORT_DECIMALS" default:"10"`
+	MissedConsecutiveThreshold    int64     `json:"missed_consecutive_threshold" envconfig:"MISSED_CONSECUTIVE_THRESHOLD" default:"1"`
+	MissedNofMThreshold           int64     `json:"missed_n_of_m_threshold" envconfig:"MISSED_N_OF_M_THRESHOLD" default:"3"`
+	MissedNofMSessions            int64     `json:"missed_n_of_m_sessions" envconfig:"MISSED_N_OF_M_SESSIONS" default:"10"`
+	ActiveBalanceChangeThresholds []float64 `json:"active_balance_change_thresholds" envconfig:"ACTIVE_BALANCE_CHANGE_THRESHOLDS" default:"0.1,1,10"`
+	CommissionChangeThresholds    []float64 `json:"commission_change_thresholds" envconfig:"COMMISSION_CHANGE_THRESHOLDS" default:"0.1,1,10"`
+	DatabaseDSN                   string    `json:"database_dsn" envconfig:"DATABASE_DSN"`
+	Debug                         bool      `json:"debug" envconfig:"DEBUG"`
+	LogLevel                      string    `json:"log_level" envconfig:"LOG_LEVEL" default:"info"`
+	LogOutput                     string    `json:"log_output" envconfig:"LOG_OUTPUT" default:"stdout"`
+	RollbarAccessToken            string    `json:"rollbar_access_token" envconfig:"ROLLBAR_ACCESS_TOKEN"`
+	RollbarServerRoot             string    `json:"rollbar_server_root" envconfig:"ROLLBAR_SERVER_ROOT"`
+	IndexerMetricAddr             string    `json:"indexer_metric_addr" envconfig:"INDEXER_METRIC_ADDR" default:":8080"`
+	ServerMetricAddr              string    `json:"server_metric_addr" envconfig:"SERVER_METRIC_ADDR" default:":8090"`
+	MetricServerUrl               string    `json:"metric_server_url" envconfig:"METRIC_SERVER_URL" default:"/metrics"`
+	PurgeSequencesInterval        string    `json:"purge_sequences_interval" envconfig:"PURGE_SEQUENCES_INTERVAL" default:"26h"`
+	PurgeHourlySummariesInterval  string    `json:"purge_hourly_summaries_interval" envconfig:"PURGE_HOURLY_SUMMARIES_INTERVAL" default:"26h"`
+	IndexerConfigFile             string    `json:"indexer_config_file" envconfig:"INDEXER_CONFIG_FILE" default:"indexer_config.json"`
 }
 
 // Validate returns an error if config is invalid
@@ -59,6 +91,42 @@ func (c *Config) Validate() error {
 		return errIndexWorkerIntervalRequired
 	}
 
+	if c.MissedConsecutiveThreshold < 1 {
+		return errMissedConsecutiveThreshold
+	}
+
+	if c.MissedNofMThreshold < 1 || c.MissedNofMThreshold > c.MissedNofMSessions {
+		return errMissedNofMThreshold
+	}
+
+	if err := validateChangeThresholds("active balance", c.ActiveBalanceChangeThresholds); err != nil {
+		return err
+	}
+
+	if err := validateChangeThresholds("commission", c.CommissionChangeThresholds); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateChangeThresholds checks that change thresholds are ascending positive percentages, one for each change level.
+// Change rates are rounded to ChangeRatePrecision, so thresholds must be its multiples to be compared with them as configured
+func validateChangeThresholds(name string, thresholds []float64) error {
+	if len(thresholds) != ChangeLevels {
+		return fmt.Errorf("%s change thresholds must have %d values, got %d", name, ChangeLevels, len(thresholds))
+	}
+	for i, threshold := range thresholds {
+		if threshold <= 0 {
+			return fmt.Errorf("%s change threshold must be greater than 0, got %v", name, threshold)
+		}
+		if steps := threshold / ChangeRatePrecision; math.Abs(steps-math.Round(steps)) > 1e-9 {
+			return fmt.Errorf("%s change threshold must be multiple of %v, got %v", name, ChangeRatePrecision, threshold)
+		}
+		if i > 0 && threshold <= thresholds[i-1] {
+			return fmt.Errorf("%s change thresholds must be ascending, got %v", name, thresholds)
+		}
+	}
 	return nil
 }
 
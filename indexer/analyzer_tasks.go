@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/figment-networks/indexing-engine/pipeline"
@@ -16,19 +17,31 @@ import (
 	"github.com/figment-networks/polkadothub-indexer/store"
 	"github.com/figment-networks/polkadothub-indexer/types"
 	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+	"github.com/figment-networks/polkadothub-proxy/grpc/event/eventpb"
 )
 
 const (
-	TaskNameEraSystemEventCreator     = "EraSystemEventCreator"
-	TaskNameSessionSystemEventCreator = "SessionSystemEventCreator"
-	TaskNameSystemEventCreator        = "SystemEventCreator"
+	TaskNameEraSystemEventCreator      = "EraSystemEventCreator"
+	TaskNameIdentitySystemEventCreator = "IdentitySystemEventCreator"
+	TaskNameRewardReconciler           = "RewardReconciler"
+	TaskNameSessionSystemEventCreator  = "SessionSystemEventCreator"
+	TaskNameSlashSystemEventCreator    = "SlashSystemEventCreator"
+	TaskNameSystemEventCreator         = "SystemEventCreator"
+
+	eventMethodPayoutStarted    = "PayoutStarted"
+	eventMethodExtrinsicFailed  = "ExtrinsicFailed"
+	eventMethodBatchInterrupted = "BatchInterrupted"
+	sectionSystem               = "system"
+	sectionUtility              = "utility"
+	eraIndexKey                 = "EraIndex"
 )
 
 var (
 	ErrActiveBalanceOutsideOfRange = errors.New("active balance is outside of specified buckets")
 	ErrCommissionOutsideOfRange    = errors.New("commission is outside of specified buckets")
 
-	missedConsecutiveThreshold int64 = 1
+	activeBalanceChangeKinds = []model.SystemEventKind{model.SystemEventActiveBalanceChange1, model.SystemEventActiveBalanceChange2, model.SystemEventActiveBalanceChange3}
+	commissionChangeKinds    = []model.SystemEventKind{model.SystemEventCommissionChange1, model.SystemEventCommissionChange2, model.SystemEventCommissionChange3}
 )
 
 // NewSystemEventCreatorTask creates system events
@@ -128,6 +141,12 @@ func (t *sessionSystemEventCreatorTask) Run(ctx context.Context, p pipeline.Payl
 	}
 	payload.SystemEvents = append(payload.SystemEvents, missedBlocksSystemEvents...)
 
+	missedNofMSystemEvents, err := t.getMissedNofMSystemEvents(payload.ValidatorSessionSequences, payload.Syncable)
+	if err != nil {
+		return err
+	}
+	payload.SystemEvents = append(payload.SystemEvents, missedNofMSystemEvents...)
+
 	return nil
 }
 
@@ -184,9 +203,442 @@ func (t *eraSystemEventCreatorTask) Run(ctx context.Context, p pipeline.Payload)
 
 	payload.SystemEvents = append(payload.SystemEvents, commissionChangeSystemEvents...)
 
+	accountChangeSystemEvents, err := t.getAccountChangeSystemEvents(payload.ValidatorEraSequences, prevEraValidatorSeqs, payload.Syncable)
+	if err != nil {
+		return err
+	}
+	payload.SystemEvents = append(payload.SystemEvents, accountChangeSystemEvents...)
+
+	return nil
+}
+
+// NewRewardReconcilerTask compares rewards predicted for claimed eras with rewards paid out on chain
+func NewRewardReconcilerTask(rewardsDb store.Rewards) *rewardReconcilerTask {
+	return &rewardReconcilerTask{
+		rewardsDb: rewardsDb,
+	}
+}
+
+type rewardReconcilerTask struct {
+	rewardsDb store.Rewards
+}
+
+func (t *rewardReconcilerTask) GetName() string {
+	return TaskNameRewardReconciler
+}
+
+func (t *rewardReconcilerTask) Run(ctx context.Context, p pipeline.Payload) error {
+	defer metric.LogIndexerTaskDuration(time.Now(), t.GetName())
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", "Analyzer", t.GetName(), payload.CurrentHeight))
+
+	// Validator can be claimed for several eras at height, all its mismatches are reported by single system event
+	var mismatchedValidators []string
+	mismatches := make(map[string][]model.RewardDiscrepancy)
+	reconciled := make(map[RewardsClaim]bool)
+
+	for _, tx := range payload.RawTransactions {
+		claims, err := getPayoutStakersClaims(tx)
+		if err != nil {
+			return err
+		}
+		if len(claims) == 0 {
+			continue
+		}
+
+		paidRewards := getPaidRewardsFromEvents(tx.GetExtrinsicIndex(), claims, payload.RawEvents)
+
+		for _, claim := range claims {
+			paid, ok := paidRewards[claim]
+			if !ok {
+				logger.Debug(fmt.Sprintf("paid rewards cannot be matched with claim [validator=%s] [era=%d] [height=%d]", claim.ValidatorStash, claim.Era, payload.CurrentHeight))
+				continue
+			}
+			if reconciled[claim] {
+				continue
+			}
+			reconciled[claim] = true
+
+			predictedSeqs, err := t.rewardsDb.FindByEraAndValidatorStash(claim.Era, claim.ValidatorStash)
+			if err != nil {
+				return err
+			}
+			// historical rewards are created from paid rewards, so there is nothing to compare against
+			if len(predictedSeqs) == 0 {
+				continue
+			}
+
+			discrepancies := getRewardDiscrepancies(claim, predictedSeqs, paid, payload.Syncable)
+			if len(discrepancies) == 0 {
+				continue
+			}
+
+			if _, ok := mismatches[claim.ValidatorStash]; !ok {
+				mismatchedValidators = append(mismatchedValidators, claim.ValidatorStash)
+			}
+			mismatches[claim.ValidatorStash] = append(mismatches[claim.ValidatorStash], discrepancies...)
+			payload.RewardDiscrepancies = append(payload.RewardDiscrepancies, discrepancies...)
+		}
+	}
+
+	for _, validatorStash := range mismatchedValidators {
+		systemEvent, err := t.getRewardMismatchSystemEvent(validatorStash, mismatches[validatorStash], payload.Syncable)
+		if err != nil {
+			return err
+		}
+		payload.SystemEvents = append(payload.SystemEvents, systemEvent)
+	}
+
+	return nil
+}
+
+func (t *rewardReconcilerTask) getRewardMismatchSystemEvent(validatorStash string, discrepancies []model.RewardDiscrepancy, syncable *model.Syncable) (model.SystemEvent, error) {
+	var eras []model.RewardMismatchEraData
+	var eraPredicted, eraActual []types.Quantity
+	eraIndexes := make(map[int64]int)
+
+	var predicted, actual types.Quantity
+	for _, d := range discrepancies {
+		i, ok := eraIndexes[d.Era]
+		if !ok {
+			i = len(eras)
+			eraIndexes[d.Era] = i
+			eras = append(eras, model.RewardMismatchEraData{Era: d.Era})
+			eraPredicted = append(eraPredicted, types.Quantity{})
+			eraActual = append(eraActual, types.Quantity{})
+		}
+		eras[i].MismatchedAccounts++
+		eraPredicted[i].Add(d.PredictedAmount)
+		eraActual[i].Add(d.ActualAmount)
+
+		predicted.Add(d.PredictedAmount)
+		actual.Add(d.ActualAmount)
+	}
+
+	for i := range eras {
+		eras[i].PredictedAmount = eraPredicted[i].String()
+		eras[i].ActualAmount = eraActual[i].String()
+	}
+
+	return newSystemEvent(validatorStash, syncable, model.SystemEventRewardMismatch, model.RewardMismatchData{
+		Eras:               eras,
+		MismatchedAccounts: int64(len(discrepancies)),
+		PredictedAmount:    predicted.String(),
+		ActualAmount:       actual.String(),
+	})
+}
+
+// getPaidRewardsFromEvents returns amounts paid out to accounts by each claim of extrinsic.
+// Rewards are attributed to claims by PayoutStarted events. When chain does not emit them, rewards can be attributed only to single claim of extrinsic.
+func getPaidRewardsFromEvents(extrinsicIndex int64, claims []RewardsClaim, events []*eventpb.Event) map[RewardsClaim]map[string]types.Quantity {
+	result := make(map[RewardsClaim]map[string]types.Quantity)
+	unattributed := make(map[string]types.Quantity)
+
+	var current *RewardsClaim
+	var payoutStarted, interrupted bool
+
+	for _, event := range events {
+		if event.GetExtrinsicIndex() != extrinsicIndex {
+			continue
+		}
+
+		switch {
+		case event.GetSection() == sectionSystem && event.GetMethod() == eventMethodExtrinsicFailed:
+			return map[RewardsClaim]map[string]types.Quantity{}
+		case event.GetSection() == sectionUtility && event.GetMethod() == eventMethodBatchInterrupted:
+			interrupted = true
+		case event.GetSection() == sectionStaking && event.GetMethod() == eventMethodPayoutStarted:
+			claim, ok := getClaimFromPayoutStartedEvent(event)
+			if !ok {
+				current = nil
+				continue
+			}
+			payoutStarted = true
+			current = &claim
+			if _, ok := result[claim]; !ok {
+				result[claim] = make(map[string]types.Quantity)
+			}
+		case event.GetSection() == sectionStaking && event.GetMethod() == eventMethodReward:
+			stash, amount, ok := getStashAndAmountFromEvent(event)
+			if !ok {
+				continue
+			}
+			if current != nil {
+				addPaidReward(result[*current], stash, amount)
+			} else if !payoutStarted {
+				addPaidReward(unattributed, stash, amount)
+			}
+		}
+	}
+
+	if !payoutStarted && !interrupted && len(claims) == 1 {
+		result[claims[0]] = unattributed
+	}
+	return result
+}
+
+func getClaimFromPayoutStartedEvent(event *eventpb.Event) (claim RewardsClaim, ok bool) {
+	for _, d := range event.GetData() {
+		switch d.GetName() {
+		case eraIndexKey:
+			era, err := strconv.ParseInt(d.GetValue(), 10, 64)
+			if err != nil {
+				return claim, false
+			}
+			claim.Era = era
+		case accountKey:
+			claim.ValidatorStash = d.GetValue()
+		}
+	}
+	return claim, claim.ValidatorStash != ""
+}
+
+func getStashAndAmountFromEvent(event *eventpb.Event) (stash string, amount types.Quantity, ok bool) {
+	for _, d := range event.GetData() {
+		switch d.GetName() {
+		case accountKey:
+			stash = d.GetValue()
+		case balanceKey:
+			var err error
+			if amount, err = types.NewQuantityFromString(d.GetValue()); err != nil {
+				return stash, amount, false
+			}
+		}
+	}
+	return stash, amount, stash != "" && amount.Valid()
+}
+
+func addPaidReward(rewards map[string]types.Quantity, stash string, amount types.Quantity) {
+	total := types.NewQuantityFromInt64(0)
+	if prev, ok := rewards[stash]; ok {
+		total.Add(prev)
+	}
+	total.Add(amount)
+	rewards[stash] = total
+}
+
+// getRewardDiscrepancies compares predicted rewards of each account with paid rewards. Commission and reward of validator are compared together
+func getRewardDiscrepancies(claim RewardsClaim, predictedSeqs []model.RewardEraSeq, paid map[string]types.Quantity, syncable *model.Syncable) []model.RewardDiscrepancy {
+	predicted := make(map[string]types.Quantity)
+	for _, seq := range predictedSeqs {
+		amount, err := types.NewQuantityFromString(seq.Amount)
+		if err != nil {
+			continue
+		}
+		addPaidReward(predicted, seq.StashAccount, amount)
+	}
+
+	var stashes []string
+	for stash := range predicted {
+		stashes = append(stashes, stash)
+	}
+	for stash := range paid {
+		if _, ok := predicted[stash]; !ok {
+			stashes = append(stashes, stash)
+		}
+	}
+	sort.Strings(stashes)
+
+	var discrepancies []model.RewardDiscrepancy
+	for _, stash := range stashes {
+		predictedAmount, ok := predicted[stash]
+		if !ok {
+			predictedAmount = types.NewQuantityFromInt64(0)
+		}
+		actualAmount, ok := paid[stash]
+		if !ok {
+			actualAmount = types.NewQuantityFromInt64(0)
+		}
+
+		if predictedAmount.Equals(actualAmount) {
+			continue
+		}
+
+		discrepancies = append(discrepancies, model.RewardDiscrepancy{
+			Height:                syncable.Height,
+			Time:                  syncable.Time,
+			Era:                   claim.Era,
+			StashAccount:          stash,
+			ValidatorStashAccount: claim.ValidatorStash,
+			PredictedAmount:       predictedAmount,
+			ActualAmount:          actualAmount,
+		})
+	}
+	return discrepancies
+}
+
+// NewIdentitySystemEventCreatorTask creates system events for changed identities of validators.
+// Identity cache is shared by all targets, so changes are compared with persisted history instead of the cache
+func NewIdentitySystemEventCreatorTask(identityDb store.Identities) *identitySystemEventCreatorTask {
+	return &identitySystemEventCreatorTask{
+		identityDb: identityDb,
+	}
+}
+
+type identitySystemEventCreatorTask struct {
+	identityDb store.Identities
+}
+
+func (t *identitySystemEventCreatorTask) GetName() string {
+	return TaskNameIdentitySystemEventCreator
+}
+
+func (t *identitySystemEventCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	defer metric.LogIndexerTaskDuration(time.Now(), t.GetName())
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", "Analyzer", t.GetName(), payload.CurrentHeight))
+
+	changes, err := t.getChanges(payload)
+	if err != nil {
+		return err
+	}
+
+	stashAccounts := make([]string, len(changes))
+	for i, change := range changes {
+		stashAccounts[i] = change.StashAccount
+	}
+
+	prevChanges, err := t.identityDb.FindLastChangesBeforeHeight(stashAccounts, payload.CurrentHeight)
+	if err != nil {
+		return err
+	}
+
+	prevChangesByStash := make(map[string]model.IdentityChange, len(prevChanges))
+	for _, prevChange := range prevChanges {
+		prevChangesByStash[prevChange.StashAccount] = prevChange
+	}
+
+	for _, change := range changes {
+		before := change.PreviousDisplayName
+		hasBefore := before != ""
+		if prevChange, ok := prevChangesByStash[change.StashAccount]; ok {
+			before = prevChange.DisplayName
+			hasBefore = true
+		}
+
+		// Display name seen for the first time is recorded in history, but it's not a change
+		if !hasBefore || before == change.DisplayName {
+			continue
+		}
+
+		systemEvent, err := newSystemEvent(change.StashAccount, payload.Syncable, model.SystemEventIdentityChanged, model.ValueChangeData{
+			Before: before,
+			After:  change.DisplayName,
+		})
+		if err != nil {
+			return err
+		}
+		payload.SystemEvents = append(payload.SystemEvents, systemEvent)
+	}
+	return nil
+}
+
+// getChanges returns identity changes found at height, either by this run or by run of other target which refreshed cache first
+func (t *identitySystemEventCreatorTask) getChanges(payload *payload) ([]model.IdentityChange, error) {
+	persistedChanges, err := t.identityDb.FindChangesByHeight(payload.CurrentHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := append([]model.IdentityChange{}, payload.IdentityChanges...)
+	isAdded := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		isAdded[change.StashAccount] = true
+	}
+	for _, change := range persistedChanges {
+		if !isAdded[change.StashAccount] {
+			isAdded[change.StashAccount] = true
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+// NewSlashSystemEventCreatorTask creates system events for slashed and offline validators and their nominators
+func NewSlashSystemEventCreatorTask() *slashSystemEventCreatorTask {
+	return &slashSystemEventCreatorTask{}
+}
+
+type slashSystemEventCreatorTask struct{}
+
+func (t *slashSystemEventCreatorTask) GetName() string {
+	return TaskNameSlashSystemEventCreator
+}
+
+func (t *slashSystemEventCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	defer metric.LogIndexerTaskDuration(time.Now(), t.GetName())
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", "Analyzer", t.GetName(), payload.CurrentHeight))
+
+	systemEvents, err := t.getSlashSystemEvents(payload.SlashSequences, payload.Syncable)
+	if err != nil {
+		return err
+	}
+	payload.SystemEvents = append(payload.SystemEvents, systemEvents...)
 	return nil
 }
 
+// getSlashSystemEvents creates single system event of each kind for account, which sums up all its slashes at height
+func (t *slashSystemEventCreatorTask) getSlashSystemEvents(slashSeqs []model.SlashSeq, syncable *model.Syncable) ([]model.SystemEvent, error) {
+	type accountSlashes struct {
+		kind                   model.SystemEventKind
+		stashAccount           string
+		validatorStashAccounts []string
+		isValidatorAdded       map[string]bool
+		amount                 types.Quantity
+	}
+
+	var slashes []*accountSlashes
+	slashesByKey := make(map[string]*accountSlashes)
+	for _, seq := range slashSeqs {
+		var kind model.SystemEventKind
+		switch seq.Kind {
+		case model.SlashKindSlash:
+			kind = model.SystemEventSlashed
+		case model.SlashKindOffline:
+			kind = model.SystemEventOfflineOffence
+		default:
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", kind, seq.StashAccount)
+		s, ok := slashesByKey[key]
+		if !ok {
+			s = &accountSlashes{kind: kind, stashAccount: seq.StashAccount, isValidatorAdded: make(map[string]bool)}
+			slashesByKey[key] = s
+			slashes = append(slashes, s)
+		}
+		if !s.isValidatorAdded[seq.ValidatorStashAccount] {
+			s.isValidatorAdded[seq.ValidatorStashAccount] = true
+			s.validatorStashAccounts = append(s.validatorStashAccounts, seq.ValidatorStashAccount)
+		}
+		s.amount.Add(seq.Amount)
+	}
+
+	var systemEvents []model.SystemEvent
+	for _, s := range slashes {
+		var data interface{}
+		if s.kind == model.SystemEventSlashed {
+			data = model.SlashedData{Era: syncable.Era, ValidatorStashAccounts: s.validatorStashAccounts, Amount: s.amount.String()}
+		} else {
+			data = model.OfflineOffenceData{Era: syncable.Era, ValidatorStashAccounts: s.validatorStashAccounts}
+		}
+
+		systemEvent, err := newSystemEvent(s.stashAccount, syncable, s.kind, data)
+		if err != nil {
+			return nil, err
+		}
+		systemEvents = append(systemEvents, systemEvent)
+	}
+	return systemEvents, nil
+}
+
 func (t *systemEventCreatorTask) getPrevHeightValidatorSequences(payload *payload) ([]model.ValidatorSeq, error) {
 	var prevValidatorSeqs []model.ValidatorSeq
 
@@ -247,7 +699,8 @@ func (t *sessionSystemEventCreatorTask) getLastSessionHeight(payload *payload) (
 func (t *sessionSystemEventCreatorTask) getMissedBlocksSystemEvents(currSeqs []model.ValidatorSessionSeq, lastSessionHeight int64, syncable *model.Syncable) ([]model.SystemEvent, error) {
 	var systemEvents []model.SystemEvent
 
-	since := syncable.Session - missedConsecutiveThreshold
+	threshold := t.cfg.MissedConsecutiveThreshold
+	since := syncable.Session - threshold
 	if since < 0 {
 		return systemEvents, nil
 	}
@@ -273,10 +726,10 @@ func (t *sessionSystemEventCreatorTask) getMissedBlocksSystemEvents(currSeqs []m
 		}
 		missed++
 
-		if missed >= missedConsecutiveThreshold {
+		if missed >= threshold {
 			newSystemEvent, err := newSystemEvent(seq.StashAccount, syncable, model.SystemEventMissedNConsecutive, model.MissedNConsecutive{
 				Missed:    missed,
-				Threshold: missedConsecutiveThreshold,
+				Threshold: threshold,
 			})
 			if err != nil {
 				return nil, err
@@ -288,6 +741,67 @@ func (t *sessionSystemEventCreatorTask) getMissedBlocksSystemEvents(currSeqs []m
 	return systemEvents, nil
 }
 
+// getMissedNofMSystemEvents creates system events for validators whose number of offline sessions within last M sessions
+// reached threshold in current session. Event is not created again until validator drops below threshold.
+func (t *sessionSystemEventCreatorTask) getMissedNofMSystemEvents(currSeqs []model.ValidatorSessionSeq, syncable *model.Syncable) ([]model.SystemEvent, error) {
+	var systemEvents []model.SystemEvent
+
+	var offline []model.ValidatorSessionSeq
+	for _, seq := range currSeqs {
+		if !seq.Online {
+			offline = append(offline, seq)
+		}
+	}
+	if len(offline) == 0 {
+		return systemEvents, nil
+	}
+
+	threshold := t.cfg.MissedNofMThreshold
+	maxSessions := t.cfg.MissedNofMSessions
+
+	firstSession := syncable.Session - maxSessions
+	if firstSession < 0 {
+		firstSession = 0
+	}
+
+	prevSeqs, err := t.validatorSessionSeqDb.FindBySessionRange(firstSession, syncable.Session-1)
+	if err != nil {
+		return nil, err
+	}
+
+	// missed counts in window of M sessions ending in previous session and in window ending in current session
+	prevWindowMissed := make(map[string]int64)
+	currWindowMissed := make(map[string]int64)
+	for _, seq := range prevSeqs {
+		if seq.Online {
+			continue
+		}
+		prevWindowMissed[seq.StashAccount]++
+		if seq.Session > syncable.Session-maxSessions {
+			currWindowMissed[seq.StashAccount]++
+		}
+	}
+
+	for _, seq := range offline {
+		missed := currWindowMissed[seq.StashAccount] + 1
+		if missed < threshold || prevWindowMissed[seq.StashAccount] >= threshold {
+			continue
+		}
+
+		newSystemEvent, err := newSystemEvent(seq.StashAccount, syncable, model.SystemEventMissedNofM, model.MissedNofMData{
+			Missed:           missed,
+			Threshold:        threshold,
+			MaxTotalSessions: maxSessions,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		systemEvents = append(systemEvents, newSystemEvent)
+	}
+	return systemEvents, nil
+}
+
 func (t *sessionSystemEventCreatorTask) getActiveSetPresenceChangeSystemEvents(currSeqs, prevSeqs []model.ValidatorSessionSeq, syncable *model.Syncable) ([]model.SystemEvent, error) {
 	var systemEvents []model.SystemEvent
 
@@ -408,6 +922,46 @@ func (t *eraSystemEventCreatorTask) getDelegationChangedSystemEvents(currSeqs, p
 	return systemEvents, nil
 }
 
+// getAccountChangeSystemEvents creates system events for validators which changed controller or session accounts since previous era
+func (t *eraSystemEventCreatorTask) getAccountChangeSystemEvents(currSeqs, prevSeqs []model.ValidatorEraSeq, syncable *model.Syncable) ([]model.SystemEvent, error) {
+	var systemEvents []model.SystemEvent
+
+	prevEraLookup := make(map[string]model.ValidatorEraSeq, len(prevSeqs))
+	for _, seq := range prevSeqs {
+		prevEraLookup[seq.StashAccount] = seq
+	}
+
+	for _, seq := range currSeqs {
+		prevSeq, ok := prevEraLookup[seq.StashAccount]
+		if !ok {
+			continue
+		}
+
+		if prevSeq.ControllerAccount != "" && seq.ControllerAccount != prevSeq.ControllerAccount {
+			systemEvent, err := newSystemEvent(seq.StashAccount, syncable, model.SystemEventControllerChanged, model.ValueChangeData{
+				Before: prevSeq.ControllerAccount,
+				After:  seq.ControllerAccount,
+			})
+			if err != nil {
+				return nil, err
+			}
+			systemEvents = append(systemEvents, systemEvent)
+		}
+
+		if len(prevSeq.SessionAccounts) > 0 && !equalAccounts(seq.SessionAccounts, prevSeq.SessionAccounts) {
+			systemEvent, err := newSystemEvent(seq.StashAccount, syncable, model.SystemEventSessionKeysRotated, model.SessionKeysRotatedData{
+				Before: prevSeq.SessionAccounts,
+				After:  seq.SessionAccounts,
+			})
+			if err != nil {
+				return nil, err
+			}
+			systemEvents = append(systemEvents, systemEvent)
+		}
+	}
+	return systemEvents, nil
+}
+
 func (t *eraSystemEventCreatorTask) getPrevValidatorEraSequences(payload *payload) (prevEraSequences []model.ValidatorEraSeq, err error) {
 	if payload.CurrentHeight > t.cfg.FirstBlockHeight {
 		prevEraSequences, err = t.validatorEraSeqDb.FindByEra(payload.Syncable.Era - 1)
@@ -446,21 +1000,16 @@ func (t *systemEventCreatorTask) getActiveBalanceChange(currValidatorSeq model.V
 	roundedChangeRate := getRoundedChangeRate(currValue, prevValue)
 	roundedAbsChangeRate := math.Abs(roundedChangeRate)
 
-	var kind model.SystemEventKind
-	if roundedAbsChangeRate >= 0.1 && roundedAbsChangeRate < 1 {
-		kind = model.SystemEventActiveBalanceChange1
-	} else if roundedAbsChangeRate >= 1 && roundedAbsChangeRate < 10 {
-		kind = model.SystemEventActiveBalanceChange2
-	} else if roundedAbsChangeRate >= 10 {
-		kind = model.SystemEventActiveBalanceChange3
-	} else {
+	level, ok := getChangeLevel(roundedAbsChangeRate, t.cfg.ActiveBalanceChangeThresholds)
+	if !ok {
 		return model.SystemEvent{}, ErrActiveBalanceOutsideOfRange
 	}
 
-	return newSystemEvent(currValidatorSeq.StashAccount, syncable, kind, model.PercentChangeData{
-		Before: prevValue,
-		After:  currValue,
-		Change: roundedChangeRate,
+	return newSystemEvent(currValidatorSeq.StashAccount, syncable, activeBalanceChangeKinds[level], model.PercentChangeData{
+		Before:    prevValue,
+		After:     currValue,
+		Change:    roundedChangeRate,
+		Threshold: t.cfg.ActiveBalanceChangeThresholds[level],
 	})
 }
 
@@ -494,24 +1043,30 @@ func (t *eraSystemEventCreatorTask) getCommissionChange(currSeq, prevSeq model.V
 	roundedChangeRate := getRoundedChangeRate(currValue, prevValue)
 	roundedAbsChangeRate := math.Abs(roundedChangeRate)
 
-	var kind model.SystemEventKind
-	if roundedAbsChangeRate >= 0.1 && roundedAbsChangeRate < 1 {
-		kind = model.SystemEventCommissionChange1
-	} else if roundedAbsChangeRate >= 1 && roundedAbsChangeRate < 10 {
-		kind = model.SystemEventCommissionChange2
-	} else if roundedAbsChangeRate >= 10 {
-		kind = model.SystemEventCommissionChange3
-	} else {
+	level, ok := getChangeLevel(roundedAbsChangeRate, t.cfg.CommissionChangeThresholds)
+	if !ok {
 		return model.SystemEvent{}, ErrCommissionOutsideOfRange
 	}
 
-	return newSystemEvent(currSeq.StashAccount, syncable, kind, model.PercentChangeData{
-		Before: prevValue,
-		After:  currValue,
-		Change: roundedChangeRate,
+	return newSystemEvent(currSeq.StashAccount, syncable, commissionChangeKinds[level], model.PercentChangeData{
+		Before:    prevValue,
+		After:     currValue,
+		Change:    roundedChangeRate,
+		Threshold: t.cfg.CommissionChangeThresholds[level],
 	})
 }
 
+// getChangeLevel returns index of highest threshold reached by absolute change rate
+func getChangeLevel(absChangeRate float64, thresholds []float64) (int, bool) {
+	for i := len(thresholds) - 1; i >= 0; i-- {
+		if absChangeRate >= thresholds[i] {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// getRoundedChangeRate returns percentage change rate rounded to config.ChangeRatePrecision
 func getRoundedChangeRate(currValue int64, prevValue int64) float64 {
 	var changeRate float64
 
@@ -521,10 +1076,29 @@ func getRoundedChangeRate(currValue int64, prevValue int64) float64 {
 		changeRate = (float64(1) - (float64(currValue) / float64(prevValue))) * 100
 	}
 
-	roundedChangeRate := math.Round(changeRate/0.1) * 0.1
+	roundedChangeRate := math.Round(changeRate/config.ChangeRatePrecision) * config.ChangeRatePrecision
 	return roundedChangeRate
 }
 
+// equalAccounts returns true when both lists contain the same accounts, regardless of their order
+func equalAccounts(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[string]int, len(a))
+	for _, account := range a {
+		counts[account]++
+	}
+	for _, account := range b {
+		if counts[account] == 0 {
+			return false
+		}
+		counts[account]--
+	}
+	return true
+}
+
 func newSystemEvent(stashAccount string, syncable *model.Syncable, kind model.SystemEventKind, data interface{}) (model.SystemEvent, error) {
 	marshaledData, err := json.Marshal(data)
 	if err != nil {
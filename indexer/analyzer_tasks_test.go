@@ -1,8 +1,11 @@
 package indexer
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -10,6 +13,8 @@ import (
 	mock "github.com/figment-networks/polkadothub-indexer/mock/store"
 	"github.com/figment-networks/polkadothub-indexer/model"
 	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-proxy/grpc/event/eventpb"
+	"github.com/figment-networks/polkadothub-proxy/grpc/transaction/transactionpb"
 	"github.com/golang/mock/gomock"
 )
 
@@ -20,7 +25,12 @@ const (
 
 var (
 	testCfg = &config.Config{
-		FirstBlockHeight: 1,
+		FirstBlockHeight:              1,
+		MissedConsecutiveThreshold:    1,
+		MissedNofMThreshold:           3,
+		MissedNofMSessions:            10,
+		ActiveBalanceChangeThresholds: []float64{0.1, 1, 10},
+		CommissionChangeThresholds:    []float64{0.1, 1, 10},
 	}
 )
 
@@ -300,10 +310,11 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEvents(t *testing.T) {
 		t.Run(tt.description, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
-			missedConsecutiveThreshold = tt.missedConsecutiveThreshold
+			cfg := *testCfg
+			cfg.MissedConsecutiveThreshold = tt.missedConsecutiveThreshold
 			systemEventStoreMock := mock.NewMockSystemEvents(ctrl)
 
-			task := NewSessionSystemEventCreatorTask(testCfg, nil, systemEventStoreMock, nil, nil)
+			task := NewSessionSystemEventCreatorTask(&cfg, nil, systemEventStoreMock, nil, nil)
 
 			kind := model.SystemEventMissedNConsecutive
 			for _, seq := range tt.currSeqs {
@@ -532,3 +543,658 @@ func TestSystemEventCreatorTask_getDelegationChangedSystemEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestRewardReconcilerTask_Run(t *testing.T) {
+	syncable := &model.Syncable{
+		Height: 20,
+		Time:   *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC)),
+	}
+
+	predicted := []model.RewardEraSeq{
+		{StashAccount: "validator1", ValidatorStashAccount: "validator1", Amount: "100", Kind: model.RewardCommission},
+		{StashAccount: "validator1", ValidatorStashAccount: "validator1", Amount: "50", Kind: model.RewardReward},
+		{StashAccount: "nominator1", ValidatorStashAccount: "validator1", Amount: "200"},
+		{StashAccount: "nominator2", ValidatorStashAccount: "validator1", Amount: "300"},
+	}
+
+	tests := []struct {
+		description         string
+		txs                 []*transactionpb.Annotated
+		events              []*eventpb.Event
+		predicted           []model.RewardEraSeq
+		expectDiscrepancies []model.RewardDiscrepancy
+	}{
+		{
+			description: "does not create discrepancies when paid rewards match predicted rewards",
+			txs:         []*transactionpb.Annotated{testPayoutStakersTxAt(1, "validator1", 182)},
+			events: []*eventpb.Event{
+				testRewardEventAt(1, "validator1", "150"),
+				testRewardEventAt(1, "nominator1", "200"),
+				testRewardEventAt(1, "nominator2", "300"),
+			},
+			predicted: predicted,
+		},
+		{
+			description: "creates discrepancies for accounts with different paid rewards",
+			txs:         []*transactionpb.Annotated{testPayoutStakersTxAt(1, "validator1", 182)},
+			events: []*eventpb.Event{
+				testRewardEventAt(1, "validator1", "150"),
+				testRewardEventAt(1, "nominator1", "199"),
+				testRewardEventAt(1, "nominator3", "10"),
+			},
+			predicted: predicted,
+			expectDiscrepancies: []model.RewardDiscrepancy{
+				{Height: 20, Time: syncable.Time, Era: 182, StashAccount: "nominator1", ValidatorStashAccount: "validator1", PredictedAmount: types.NewQuantityFromInt64(200), ActualAmount: types.NewQuantityFromInt64(199)},
+				{Height: 20, Time: syncable.Time, Era: 182, StashAccount: "nominator2", ValidatorStashAccount: "validator1", PredictedAmount: types.NewQuantityFromInt64(300), ActualAmount: types.NewQuantityFromInt64(0)},
+				{Height: 20, Time: syncable.Time, Era: 182, StashAccount: "nominator3", ValidatorStashAccount: "validator1", PredictedAmount: types.NewQuantityFromInt64(0), ActualAmount: types.NewQuantityFromInt64(10)},
+			},
+		},
+		{
+			description: "attributes rewards of batch to claims by payout started events",
+			txs:         []*transactionpb.Annotated{testBatchTxAt(1, testPayoutStakersCall("validator2", "182"), testPayoutStakersCall("validator1", "182"))},
+			events: []*eventpb.Event{
+				testPayoutStartedEventAt(1, "validator2", 182),
+				testRewardEventAt(1, "nominator1", "999"),
+				testPayoutStartedEventAt(1, "validator1", 182),
+				testRewardEventAt(1, "validator1", "150"),
+				testRewardEventAt(1, "nominator1", "200"),
+				testRewardEventAt(1, "nominator2", "301"),
+			},
+			predicted: predicted,
+			expectDiscrepancies: []model.RewardDiscrepancy{
+				{Height: 20, Time: syncable.Time, Era: 182, StashAccount: "nominator2", ValidatorStashAccount: "validator1", PredictedAmount: types.NewQuantityFromInt64(300), ActualAmount: types.NewQuantityFromInt64(301)},
+			},
+		},
+		{
+			description: "creates discrepancies for all eras of validator claimed by batch",
+			txs:         []*transactionpb.Annotated{testBatchTxAt(1, testPayoutStakersCall("validator1", "181"), testPayoutStakersCall("validator1", "182"))},
+			events: []*eventpb.Event{
+				testPayoutStartedEventAt(1, "validator1", 181),
+				testRewardEventAt(1, "validator1", "150"),
+				testRewardEventAt(1, "nominator1", "199"),
+				testRewardEventAt(1, "nominator2", "300"),
+				testPayoutStartedEventAt(1, "validator1", 182),
+				testRewardEventAt(1, "validator1", "150"),
+				testRewardEventAt(1, "nominator1", "200"),
+				testRewardEventAt(1, "nominator2", "301"),
+			},
+			predicted: predicted,
+			expectDiscrepancies: []model.RewardDiscrepancy{
+				{Height: 20, Time: syncable.Time, Era: 181, StashAccount: "nominator1", ValidatorStashAccount: "validator1", PredictedAmount: types.NewQuantityFromInt64(200), ActualAmount: types.NewQuantityFromInt64(199)},
+				{Height: 20, Time: syncable.Time, Era: 182, StashAccount: "nominator2", ValidatorStashAccount: "validator1", PredictedAmount: types.NewQuantityFromInt64(300), ActualAmount: types.NewQuantityFromInt64(301)},
+			},
+		},
+		{
+			description: "does not reconcile batch without payout started events",
+			txs:         []*transactionpb.Annotated{testBatchTxAt(1, testPayoutStakersCall("validator1", "182"), testPayoutStakersCall("validator2", "182"))},
+			events:      []*eventpb.Event{testRewardEventAt(1, "nominator1", "1")},
+			predicted:   predicted,
+		},
+		{
+			description: "does not reconcile failed extrinsic",
+			txs:         []*transactionpb.Annotated{testPayoutStakersTxAt(1, "validator1", 182)},
+			events:      []*eventpb.Event{{ExtrinsicIndex: 1, Section: "system", Method: "ExtrinsicFailed"}},
+			predicted:   predicted,
+		},
+		{
+			description: "ignores rewards of other extrinsics",
+			txs:         []*transactionpb.Annotated{testPayoutStakersTxAt(1, "validator1", 182)},
+			events: []*eventpb.Event{
+				testRewardEventAt(1, "validator1", "150"),
+				testRewardEventAt(1, "nominator1", "200"),
+				testRewardEventAt(1, "nominator2", "300"),
+				testRewardEventAt(2, "nominator2", "300"),
+			},
+			predicted: predicted,
+		},
+		{
+			description: "does not reconcile rewards without predictions",
+			txs:         []*transactionpb.Annotated{testPayoutStakersTxAt(1, "validator1", 182)},
+			events:      []*eventpb.Event{testRewardEventAt(1, "validator1", "150")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			rewardsMock := mock.NewMockRewards(ctrl)
+			rewardsMock.EXPECT().FindByEraAndValidatorStash(gomock.Any(), gomock.Any()).DoAndReturn(func(era int64, validatorStash string) ([]model.RewardEraSeq, error) {
+				if validatorStash != "validator1" {
+					return nil, nil
+				}
+				return tt.predicted, nil
+			}).AnyTimes()
+
+			task := NewRewardReconcilerTask(rewardsMock)
+
+			pl := &payload{
+				CurrentHeight:   syncable.Height,
+				Syncable:        syncable,
+				RawTransactions: tt.txs,
+				RawEvents:       tt.events,
+			}
+
+			if err := task.Run(context.Background(), pl); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if len(pl.RewardDiscrepancies) != len(tt.expectDiscrepancies) {
+				t.Errorf("unexpected discrepancies count, want: %d; got: %d (%+v)", len(tt.expectDiscrepancies), len(pl.RewardDiscrepancies), pl.RewardDiscrepancies)
+				return
+			}
+
+			for i, expect := range tt.expectDiscrepancies {
+				got := pl.RewardDiscrepancies[i]
+				if got.StashAccount != expect.StashAccount || got.ValidatorStashAccount != expect.ValidatorStashAccount || got.Era != expect.Era || got.Height != expect.Height ||
+					!got.PredictedAmount.Equals(expect.PredictedAmount) || !got.ActualAmount.Equals(expect.ActualAmount) {
+					t.Errorf("unexpected discrepancy, want: %+v; got: %+v", expect, got)
+				}
+			}
+
+			if len(tt.expectDiscrepancies) == 0 {
+				if len(pl.SystemEvents) != 0 {
+					t.Errorf("unexpected system events: %+v", pl.SystemEvents)
+				}
+				return
+			}
+
+			if len(pl.SystemEvents) != 1 {
+				t.Errorf("unexpected system events count, want: %d; got: %d", 1, len(pl.SystemEvents))
+				return
+			}
+
+			systemEvent := pl.SystemEvents[0]
+			if systemEvent.Kind != model.SystemEventRewardMismatch || systemEvent.Actor != "validator1" {
+				t.Errorf("unexpected system event: %+v", systemEvent)
+			}
+
+			var data model.RewardMismatchData
+			if err := json.Unmarshal(systemEvent.Data.RawMessage, &data); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if data.MismatchedAccounts != int64(len(tt.expectDiscrepancies)) {
+				t.Errorf("unexpected system event data: %+v", data)
+			}
+
+			var expectEras []int64
+			for _, d := range tt.expectDiscrepancies {
+				if len(expectEras) == 0 || expectEras[len(expectEras)-1] != d.Era {
+					expectEras = append(expectEras, d.Era)
+				}
+			}
+			var eras []int64
+			var eraAccounts int64
+			for _, era := range data.Eras {
+				eras = append(eras, era.Era)
+				eraAccounts += era.MismatchedAccounts
+			}
+			if !reflect.DeepEqual(eras, expectEras) || eraAccounts != data.MismatchedAccounts {
+				t.Errorf("unexpected eras of system event, want: %v; got: %+v", expectEras, data.Eras)
+			}
+		})
+	}
+}
+
+func testPayoutStakersTxAt(extrinsicIndex int64, stash string, era int64) *transactionpb.Annotated {
+	tx := testPayoutStakersTx(stash, era)
+	tx.ExtrinsicIndex = extrinsicIndex
+	return tx
+}
+
+func testBatchTxAt(extrinsicIndex int64, calls ...map[string]interface{}) *transactionpb.Annotated {
+	tx := testBatchTx("batch", calls...)
+	tx.ExtrinsicIndex = extrinsicIndex
+	return tx
+}
+
+func testRewardEventAt(extrinsicIndex int64, stash, amount string) *eventpb.Event {
+	return &eventpb.Event{ExtrinsicIndex: extrinsicIndex, Method: "Reward", Section: "staking", Data: []*eventpb.EventData{{Name: "AccountId", Value: stash}, {Name: "Balance", Value: amount}}}
+}
+
+func testPayoutStartedEventAt(extrinsicIndex int64, stash string, era int64) *eventpb.Event {
+	return &eventpb.Event{ExtrinsicIndex: extrinsicIndex, Method: "PayoutStarted", Section: "staking", Data: []*eventpb.EventData{{Name: "EraIndex", Value: fmt.Sprint(era)}, {Name: "AccountId", Value: stash}}}
+}
+
+func TestSlashSystemEventCreatorTask_Run(t *testing.T) {
+	syncable := &model.Syncable{
+		Height: 20,
+		Era:    182,
+		Time:   *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC)),
+	}
+	seq := &model.Sequence{Height: syncable.Height, Time: syncable.Time}
+
+	pl := &payload{
+		Syncable: syncable,
+		SlashSequences: []model.SlashSeq{
+			{Sequence: seq, Era: 182, EventIndex: 1, Kind: model.SlashKindSlash, StashAccount: "validator1", ValidatorStashAccount: "validator1", Amount: types.NewQuantityFromInt64(100)},
+			{Sequence: seq, Era: 182, EventIndex: 2, Kind: model.SlashKindSlash, StashAccount: "nominator1", ValidatorStashAccount: "validator1", Amount: types.NewQuantityFromInt64(10)},
+			{Sequence: seq, Era: 182, EventIndex: 3, Kind: model.SlashKindSlash, StashAccount: "validator3", ValidatorStashAccount: "validator3", Amount: types.NewQuantityFromInt64(50)},
+			{Sequence: seq, Era: 182, EventIndex: 4, Kind: model.SlashKindSlash, StashAccount: "nominator1", ValidatorStashAccount: "validator3", Amount: types.NewQuantityFromInt64(5)},
+			{Sequence: seq, Era: 182, EventIndex: 5, Kind: model.SlashKindOffence, OffenceKind: "im-online:offlin"},
+			{Sequence: seq, Era: 182, EventIndex: 6, Kind: model.SlashKindOffline, StashAccount: "validator2", ValidatorStashAccount: "validator2"},
+			{Sequence: seq, Era: 182, EventIndex: 6, Kind: model.SlashKindOffline, StashAccount: "nominator1", ValidatorStashAccount: "validator2"},
+		},
+	}
+
+	if err := NewSlashSystemEventCreatorTask().Run(context.Background(), pl); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	expect := []struct {
+		actor      string
+		kind       model.SystemEventKind
+		validators []string
+		amount     string
+	}{
+		{"validator1", model.SystemEventSlashed, []string{"validator1"}, "100"},
+		{"nominator1", model.SystemEventSlashed, []string{"validator1", "validator3"}, "15"},
+		{"validator3", model.SystemEventSlashed, []string{"validator3"}, "50"},
+		{"validator2", model.SystemEventOfflineOffence, []string{"validator2"}, ""},
+		{"nominator1", model.SystemEventOfflineOffence, []string{"validator2"}, ""},
+	}
+
+	if len(pl.SystemEvents) != len(expect) {
+		t.Errorf("unexpected system events count, want: %d; got: %d", len(expect), len(pl.SystemEvents))
+		return
+	}
+
+	for i, e := range expect {
+		got := pl.SystemEvents[i]
+		if got.Actor != e.actor || got.Kind != e.kind || got.Height != syncable.Height {
+			t.Errorf("unexpected system event, want: %+v; got: %+v", e, got)
+		}
+
+		var data model.SlashedData
+		if err := json.Unmarshal(got.Data.RawMessage, &data); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if !reflect.DeepEqual(data.ValidatorStashAccounts, e.validators) || data.Amount != e.amount || data.Era != 182 {
+			t.Errorf("unexpected system event data: %+v", data)
+		}
+	}
+}
+
+func TestSystemEventCreatorTask_getActiveBalanceChange_configuredThresholds(t *testing.T) {
+	cfg := *testCfg
+	cfg.ActiveBalanceChangeThresholds = []float64{5, 20, 50}
+
+	syncable := &model.Syncable{Height: 20}
+	seq := &model.Sequence{Height: syncable.Height}
+
+	tests := []struct {
+		description       string
+		after             int64
+		expectedKind      model.SystemEventKind
+		expectedThreshold float64
+		expectedErr       error
+	}{
+		{"returns error when change is below lowest threshold", 1040, "", 0, ErrActiveBalanceOutsideOfRange},
+		{"returns activeBalanceChange1 when change reaches first threshold", 1050, model.SystemEventActiveBalanceChange1, 5, nil},
+		{"returns activeBalanceChange2 when change reaches second threshold", 800, model.SystemEventActiveBalanceChange2, 20, nil},
+		{"returns activeBalanceChange3 when change reaches third threshold", 1500, model.SystemEventActiveBalanceChange3, 50, nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			task := NewSystemEventCreatorTask(&cfg, nil)
+			prev := model.ValidatorSeq{Sequence: seq, StashAccount: testValidatorAddress, ActiveBalance: types.NewQuantityFromInt64(1000)}
+			curr := model.ValidatorSeq{Sequence: seq, StashAccount: testValidatorAddress, ActiveBalance: types.NewQuantityFromInt64(tt.after)}
+
+			systemEvent, err := task.getActiveBalanceChange(curr, prev, syncable)
+			if err != tt.expectedErr {
+				t.Errorf("unexpected error, want %v; got %v", tt.expectedErr, err)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			if systemEvent.Kind != tt.expectedKind {
+				t.Errorf("unexpected system event kind, want %v; got %v", tt.expectedKind, systemEvent.Kind)
+			}
+
+			var data model.PercentChangeData
+			if err := json.Unmarshal(systemEvent.Data.RawMessage, &data); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if data.Threshold != tt.expectedThreshold {
+				t.Errorf("unexpected threshold, want %v; got %v", tt.expectedThreshold, data.Threshold)
+			}
+		})
+	}
+}
+
+func TestSessionSystemEventCreatorTask_getMissedNofMSystemEvents(t *testing.T) {
+	testSyncable := &model.Syncable{Height: 100, Session: 20}
+	testErr := errors.New("test err")
+
+	sessionSeq := func(session int64, stash string, online bool) model.ValidatorSessionSeq {
+		return model.ValidatorSessionSeq{SessionSequence: &model.SessionSequence{Session: session}, StashAccount: stash, Online: online}
+	}
+
+	tests := []struct {
+		description string
+		currSeqs    []model.ValidatorSessionSeq
+		prevSeqs    []model.ValidatorSessionSeq
+		dbErr       error
+
+		expectedActors []string
+		expectedMissed []int64
+		expectedErr    error
+	}{
+		{
+			description: "returns no system events when validators are online",
+			currSeqs:    []model.ValidatorSessionSeq{sessionSeq(20, testValidatorAddress, true)},
+		},
+		{
+			description: "returns no system events when missed count is below threshold",
+			currSeqs:    []model.ValidatorSessionSeq{sessionSeq(20, testValidatorAddress, false)},
+			prevSeqs:    []model.ValidatorSessionSeq{sessionSeq(15, testValidatorAddress, false), sessionSeq(16, testValidatorAddress, true)},
+		},
+		{
+			description:    "returns system event when missed count reaches threshold",
+			currSeqs:       []model.ValidatorSessionSeq{sessionSeq(20, testValidatorAddress, false)},
+			prevSeqs:       []model.ValidatorSessionSeq{sessionSeq(11, testValidatorAddress, false), sessionSeq(15, testValidatorAddress, false)},
+			expectedActors: []string{testValidatorAddress},
+			expectedMissed: []int64{3},
+		},
+		{
+			description: "returns no system events when threshold was already reached in previous window",
+			currSeqs:    []model.ValidatorSessionSeq{sessionSeq(20, testValidatorAddress, false)},
+			prevSeqs:    []model.ValidatorSessionSeq{sessionSeq(12, testValidatorAddress, false), sessionSeq(15, testValidatorAddress, false), sessionSeq(19, testValidatorAddress, false)},
+		},
+		{
+			description: "returns no system events when missed count stays at threshold as oldest missed session leaves window",
+			currSeqs:    []model.ValidatorSessionSeq{sessionSeq(20, testValidatorAddress, false)},
+			prevSeqs:    []model.ValidatorSessionSeq{sessionSeq(10, testValidatorAddress, false), sessionSeq(11, testValidatorAddress, false), sessionSeq(15, testValidatorAddress, false)},
+		},
+		{
+			description:    "returns system events only for validators crossing threshold",
+			currSeqs:       []model.ValidatorSessionSeq{sessionSeq(20, testValidatorAddress, false), sessionSeq(20, "validator2", false)},
+			prevSeqs:       []model.ValidatorSessionSeq{sessionSeq(13, testValidatorAddress, false), sessionSeq(14, testValidatorAddress, false), sessionSeq(14, "validator2", false)},
+			expectedActors: []string{testValidatorAddress},
+			expectedMissed: []int64{3},
+		},
+		{
+			description: "returns error if db errors",
+			currSeqs:    []model.ValidatorSessionSeq{sessionSeq(20, testValidatorAddress, false)},
+			dbErr:       testErr,
+			expectedErr: testErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			validatorSessionSeqStoreMock := mock.NewMockValidatorSessionSeq(ctrl)
+			for _, seq := range tt.currSeqs {
+				if !seq.Online {
+					validatorSessionSeqStoreMock.EXPECT().FindBySessionRange(int64(10), int64(19)).Return(tt.prevSeqs, tt.dbErr).Times(1)
+					break
+				}
+			}
+
+			task := NewSessionSystemEventCreatorTask(testCfg, nil, nil, nil, validatorSessionSeqStoreMock)
+
+			createdSystemEvents, err := task.getMissedNofMSystemEvents(tt.currSeqs, testSyncable)
+			if err != tt.expectedErr {
+				t.Errorf("unexpected error, want %v; got %v", tt.expectedErr, err)
+				return
+			}
+
+			if len(createdSystemEvents) != len(tt.expectedActors) {
+				t.Errorf("unexpected system event count, want %v; got %v", len(tt.expectedActors), len(createdSystemEvents))
+				return
+			}
+
+			for i, systemEvent := range createdSystemEvents {
+				if systemEvent.Kind != model.SystemEventMissedNofM || systemEvent.Actor != tt.expectedActors[i] {
+					t.Errorf("unexpected system event, want %v for %v; got %v for %v", model.SystemEventMissedNofM, tt.expectedActors[i], systemEvent.Kind, systemEvent.Actor)
+				}
+
+				var data model.MissedNofMData
+				if err := json.Unmarshal(systemEvent.Data.RawMessage, &data); err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				if data.Missed != tt.expectedMissed[i] || data.Threshold != 3 || data.MaxTotalSessions != 10 {
+					t.Errorf("unexpected system event data: %+v", data)
+				}
+			}
+		})
+	}
+}
+
+func TestEraSystemEventCreatorTask_getAccountChangeSystemEvents(t *testing.T) {
+	currSyncable := &model.Syncable{
+		Height: 20,
+		Time:   *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		description  string
+		prevSeq      model.ValidatorEraSeq
+		currSeq      model.ValidatorEraSeq
+		expectKinds  []model.SystemEventKind
+		expectBefore string
+		expectAfter  string
+	}{
+		{
+			description: "returns no system events when accounts haven't changed",
+			prevSeq:     model.ValidatorEraSeq{StashAccount: testValidatorAddress, ControllerAccount: "ctrl1", SessionAccounts: []string{"key1", "key2"}},
+			currSeq:     model.ValidatorEraSeq{StashAccount: testValidatorAddress, ControllerAccount: "ctrl1", SessionAccounts: []string{"key1", "key2"}},
+		},
+		{
+			description: "returns no system events when session keys are reordered",
+			prevSeq:     model.ValidatorEraSeq{StashAccount: testValidatorAddress, ControllerAccount: "ctrl1", SessionAccounts: []string{"key1", "key2"}},
+			currSeq:     model.ValidatorEraSeq{StashAccount: testValidatorAddress, ControllerAccount: "ctrl1", SessionAccounts: []string{"key2", "key1"}},
+		},
+		{
+			description:  "returns controllerChanged system event when controller changed",
+			prevSeq:      model.ValidatorEraSeq{StashAccount: testValidatorAddress, ControllerAccount: "ctrl1", SessionAccounts: []string{"key1"}},
+			currSeq:      model.ValidatorEraSeq{StashAccount: testValidatorAddress, ControllerAccount: "ctrl2", SessionAccounts: []string{"key1"}},
+			expectKinds:  []model.SystemEventKind{model.SystemEventControllerChanged},
+			expectBefore: "ctrl1",
+			expectAfter:  "ctrl2",
+		},
+		{
+			description: "returns sessionKeysRotated system event when session keys changed",
+			prevSeq:     model.ValidatorEraSeq{StashAccount: testValidatorAddress, ControllerAccount: "ctrl1", SessionAccounts: []string{"key1", "key2"}},
+			currSeq:     model.ValidatorEraSeq{StashAccount: testValidatorAddress, ControllerAccount: "ctrl1", SessionAccounts: []string{"key1", "key3"}},
+			expectKinds: []model.SystemEventKind{model.SystemEventSessionKeysRotated},
+		},
+		{
+			description: "returns both system events when controller and session keys changed",
+			prevSeq:     model.ValidatorEraSeq{StashAccount: testValidatorAddress, ControllerAccount: "ctrl1", SessionAccounts: []string{"key1"}},
+			currSeq:     model.ValidatorEraSeq{StashAccount: testValidatorAddress, ControllerAccount: "ctrl2", SessionAccounts: []string{"key2"}},
+			expectKinds: []model.SystemEventKind{model.SystemEventControllerChanged, model.SystemEventSessionKeysRotated},
+		},
+		{
+			description: "returns no system events when previous accounts are unknown",
+			prevSeq:     model.ValidatorEraSeq{StashAccount: testValidatorAddress},
+			currSeq:     model.ValidatorEraSeq{StashAccount: testValidatorAddress, ControllerAccount: "ctrl1", SessionAccounts: []string{"key1"}},
+		},
+		{
+			description: "returns no system events when validator wasn't in previous era",
+			prevSeq:     model.ValidatorEraSeq{StashAccount: "other", ControllerAccount: "ctrl1", SessionAccounts: []string{"key1"}},
+			currSeq:     model.ValidatorEraSeq{StashAccount: testValidatorAddress, ControllerAccount: "ctrl2", SessionAccounts: []string{"key2"}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			task := NewEraSystemEventCreatorTask(testCfg, nil, nil)
+			createdSystemEvents, err := task.getAccountChangeSystemEvents([]model.ValidatorEraSeq{tt.currSeq}, []model.ValidatorEraSeq{tt.prevSeq}, currSyncable)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if len(createdSystemEvents) != len(tt.expectKinds) {
+				t.Errorf("unexpected system event count, want %v; got %v", len(tt.expectKinds), len(createdSystemEvents))
+				return
+			}
+
+			for i, kind := range tt.expectKinds {
+				if createdSystemEvents[i].Kind != kind || createdSystemEvents[i].Actor != testValidatorAddress {
+					t.Errorf("unexpected system event, want kind %v; got %+v", kind, createdSystemEvents[i])
+				}
+			}
+
+			if tt.expectBefore != "" {
+				var data model.ValueChangeData
+				if err := json.Unmarshal(createdSystemEvents[0].Data.RawMessage, &data); err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				if data.Before != tt.expectBefore || data.After != tt.expectAfter {
+					t.Errorf("unexpected system event data: %+v", data)
+				}
+			}
+		})
+	}
+}
+
+func TestIdentitySystemEventCreatorTask_Run(t *testing.T) {
+	syncable := &model.Syncable{
+		Height: 20,
+		Time:   *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC)),
+	}
+
+	type expectedEvent struct {
+		actor  string
+		before string
+		after  string
+	}
+
+	tests := []struct {
+		description      string
+		changes          []model.IdentityChange
+		persistedChanges []model.IdentityChange
+		prevChanges      []model.IdentityChange
+		expect           []expectedEvent
+	}{
+		{
+			description: "creates system events for changes compared with previous persisted changes",
+			changes: []model.IdentityChange{
+				{Height: 20, StashAccount: "validator1", DisplayName: "new", PreviousDisplayName: "old"},
+				{Height: 20, StashAccount: "validator2", DisplayName: "", PreviousDisplayName: "removed"},
+			},
+			prevChanges: []model.IdentityChange{
+				{Height: 10, StashAccount: "validator1", DisplayName: "old"},
+				{Height: 5, StashAccount: "validator2", DisplayName: "removed"},
+			},
+			expect: []expectedEvent{
+				{"validator1", "old", "new"},
+				{"validator2", "removed", ""},
+			},
+		},
+		{
+			description: "creates system events for changes persisted at height by other target",
+			persistedChanges: []model.IdentityChange{
+				{Height: 20, StashAccount: "validator1", DisplayName: "new", PreviousDisplayName: "old"},
+			},
+			prevChanges: []model.IdentityChange{
+				{Height: 10, StashAccount: "validator1", DisplayName: "old"},
+			},
+			expect: []expectedEvent{
+				{"validator1", "old", "new"},
+			},
+		},
+		{
+			description: "uses previous persisted change instead of display name found in cache",
+			changes: []model.IdentityChange{
+				{Height: 20, StashAccount: "validator1", DisplayName: "new", PreviousDisplayName: "new"},
+				{Height: 20, StashAccount: "validator2", DisplayName: "new", PreviousDisplayName: "old"},
+			},
+			persistedChanges: []model.IdentityChange{
+				{Height: 20, StashAccount: "validator1", DisplayName: "new", PreviousDisplayName: "new"},
+			},
+			prevChanges: []model.IdentityChange{
+				{Height: 10, StashAccount: "validator1", DisplayName: "old"},
+				{Height: 10, StashAccount: "validator2", DisplayName: "new"},
+			},
+			expect: []expectedEvent{
+				{"validator1", "old", "new"},
+			},
+		},
+		{
+			description: "does not create system events for display names seen for the first time",
+			changes: []model.IdentityChange{
+				{Height: 20, StashAccount: "validator1", DisplayName: "new"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var stashAccounts []string
+			isAdded := make(map[string]bool)
+			for _, change := range append(append([]model.IdentityChange{}, tt.changes...), tt.persistedChanges...) {
+				if !isAdded[change.StashAccount] {
+					isAdded[change.StashAccount] = true
+					stashAccounts = append(stashAccounts, change.StashAccount)
+				}
+			}
+
+			dbMock := mock.NewMockIdentities(ctrl)
+			dbMock.EXPECT().FindChangesByHeight(syncable.Height).Return(tt.persistedChanges, nil).Times(1)
+			dbMock.EXPECT().FindLastChangesBeforeHeight(gomock.Len(len(stashAccounts)), syncable.Height).Return(tt.prevChanges, nil).Times(1)
+
+			pl := &payload{
+				CurrentHeight:   syncable.Height,
+				Syncable:        syncable,
+				IdentityChanges: tt.changes,
+			}
+
+			if err := NewIdentitySystemEventCreatorTask(dbMock).Run(context.Background(), pl); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if len(pl.SystemEvents) != len(tt.expect) {
+				t.Errorf("unexpected system events count, want: %d; got: %d", len(tt.expect), len(pl.SystemEvents))
+				return
+			}
+
+			for i, e := range tt.expect {
+				got := pl.SystemEvents[i]
+				if got.Actor != e.actor || got.Kind != model.SystemEventIdentityChanged || got.Height != syncable.Height {
+					t.Errorf("unexpected system event: %+v", got)
+				}
+
+				var data model.ValueChangeData
+				if err := json.Unmarshal(got.Data.RawMessage, &data); err != nil {
+					t.Errorf("unexpected error: %v", err)
+					continue
+				}
+				if data.Before != e.before || data.After != e.after {
+					t.Errorf("unexpected system event data, want: %+v; got: %+v", e, data)
+				}
+			}
+		})
+	}
+}
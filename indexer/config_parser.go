@@ -31,6 +31,11 @@ type ConfigParser interface {
 	GetAllVersionedTasks() ([]pipeline.TaskName, error)
 	GetTasksByVersionIds([]int64) ([]pipeline.TaskName, error)
 	GetTasksByTargetIds([]int64) ([]pipeline.TaskName, error)
+	GetTargetIdsByTaskName(pipeline.TaskName) []int64
+	GetAllVersionedTargetIds() []int64
+	GetTargetVersionId(targetId int64) int64
+	GetTargetName(targetId int64) string
+	IsAnyTargetSequential(targetIds []int64) bool
 }
 
 type indexerConfig struct {
@@ -214,6 +219,70 @@ func (o *configParser) GetTasksByTargetIds(targetIds []int64) ([]pipeline.TaskNa
 	return getUniqueTaskNames(allTaskNames), nil
 }
 
+// GetTargetIdsByTaskName get list of target ids which include desired task
+func (o *configParser) GetTargetIdsByTaskName(taskName pipeline.TaskName) []int64 {
+	var targetIds []int64
+	for _, t := range o.targets.AvailableTargets {
+		for _, name := range t.Tasks {
+			if name == taskName {
+				targetIds = append(targetIds, t.ID)
+				break
+			}
+		}
+	}
+	return targetIds
+}
+
+// GetAllVersionedTargetIds get list of unique target ids included in any version
+func (o *configParser) GetAllVersionedTargetIds() []int64 {
+	keys := make(map[int64]bool)
+	var targetIds []int64
+	for _, v := range o.targets.Versions {
+		for _, targetId := range v.Targets {
+			if !keys[targetId] {
+				keys[targetId] = true
+				targetIds = append(targetIds, targetId)
+			}
+		}
+	}
+	return targetIds
+}
+
+// GetTargetVersionId gets id of the most recent version which includes target.
+// Heights indexed before that version need to be reindexed for target.
+func (o *configParser) GetTargetVersionId(targetId int64) int64 {
+	var versionId int64
+	for _, v := range o.targets.Versions {
+		for _, id := range v.Targets {
+			if id == targetId && v.ID > versionId {
+				versionId = v.ID
+			}
+		}
+	}
+	return versionId
+}
+
+// GetTargetName gets name of target
+func (o *configParser) GetTargetName(targetId int64) string {
+	for _, t := range o.targets.AvailableTargets {
+		if t.ID == targetId {
+			return t.Name
+		}
+	}
+	return ""
+}
+
+// IsAnyTargetSequential checks if most recent version of any of targets is sequential
+func (o *configParser) IsAnyTargetSequential(targetIds []int64) bool {
+	for _, targetId := range targetIds {
+		versionId := o.GetTargetVersionId(targetId)
+		if o.IsAnyVersionSequential([]int64{versionId}) {
+			return true
+		}
+	}
+	return false
+}
+
 // getTasksByTargetId get list of tasks for desired target id
 func (o *configParser) getTasksByTargetId(targetId int64) ([]pipeline.TaskName, error) {
 	for _, t := range o.targets.AvailableTargets {
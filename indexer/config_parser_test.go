@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/figment-networks/polkadothub-indexer/utils/test"
@@ -508,3 +509,58 @@ func TestConfigParser_IsAnyVersionSequential(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigParser_targets(t *testing.T) {
+	fileName := "test_indexer_config.json"
+	input := []byte(`{
+		"versions": [
+			{"id": 1, "targets": [1, 2], "parallel": false},
+			{"id": 2, "targets": [2], "parallel": true},
+			{"id": 3, "targets": [3], "parallel": false}
+		],
+		"available_targets": [
+			{"id": 1, "name": "target1"},
+			{"id": 2, "name": "target2"},
+			{"id": 3, "name": "target3"}
+		]
+	}`)
+
+	test.CreateFile(t, fileName, input)
+	defer test.CleanUp(t, fileName)
+
+	parser, err := NewConfigParser(fileName)
+	if err != nil {
+		t.Errorf("NewConfigParser should not return error: err=%+v", err)
+		return
+	}
+
+	t.Run("GetAllVersionedTargetIds returns unique target ids", func(t *testing.T) {
+		got := parser.GetAllVersionedTargetIds()
+		if !reflect.DeepEqual(got, []int64{1, 2, 3}) {
+			t.Errorf("unexpected target ids, want: %v; got: %v", []int64{1, 2, 3}, got)
+		}
+	})
+
+	t.Run("GetTargetVersionId returns most recent version of target", func(t *testing.T) {
+		for targetId, want := range map[int64]int64{1: 1, 2: 2, 3: 3, 4: 0} {
+			if got := parser.GetTargetVersionId(targetId); got != want {
+				t.Errorf("unexpected version id of target %d, want: %d; got: %d", targetId, want, got)
+			}
+		}
+	})
+
+	t.Run("GetTargetName returns name of target", func(t *testing.T) {
+		if got := parser.GetTargetName(2); got != "target2" {
+			t.Errorf("unexpected target name, want: %s; got: %s", "target2", got)
+		}
+	})
+
+	t.Run("IsAnyTargetSequential checks most recent version of targets", func(t *testing.T) {
+		if parser.IsAnyTargetSequential([]int64{2}) {
+			t.Errorf("target 2 should not be sequential")
+		}
+		if !parser.IsAnyTargetSequential([]int64{2, 3}) {
+			t.Errorf("target 3 should be sequential")
+		}
+	})
+}
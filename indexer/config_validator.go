@@ -0,0 +1,273 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+)
+
+// pipelineTask describes task registered in pipeline
+type pipelineTask struct {
+	stage pipeline.StageName
+	// dependencies are tasks which set payload data read by task
+	dependencies []pipeline.TaskName
+}
+
+// pipelineTasks are all tasks registered in pipeline, indexer config can only refer to them
+var pipelineTasks = map[pipeline.TaskName]pipelineTask{
+	FetcherTaskName:          {stage: pipeline.StageFetcher},
+	ValidatorFetcherTaskName: {stage: pipeline.StageFetcher},
+
+	// MainSyncer reads height meta of fetcher only for heights which were not synced yet, and all targets run for them
+	MainSyncerTaskName: {stage: pipeline.StageSyncer},
+
+	BlockParserTaskName:      {stage: pipeline.StageParser, dependencies: []pipeline.TaskName{FetcherTaskName}},
+	ValidatorsParserTaskName: {stage: pipeline.StageParser, dependencies: []pipeline.TaskName{FetcherTaskName}},
+
+	BlockSeqCreatorTaskName:            {stage: pipeline.StageSequencer, dependencies: []pipeline.TaskName{FetcherTaskName, BlockParserTaskName}},
+	ValidatorSeqCreatorTaskName:        {stage: pipeline.StageSequencer, dependencies: []pipeline.TaskName{ValidatorFetcherTaskName}},
+	ValidatorSessionSeqCreatorTaskName: {stage: pipeline.StageSequencer, dependencies: []pipeline.TaskName{FetcherTaskName}},
+	ValidatorEraSeqCreatorTaskName:     {stage: pipeline.StageSequencer, dependencies: []pipeline.TaskName{FetcherTaskName}},
+	EventSeqCreatorTaskName:            {stage: pipeline.StageSequencer, dependencies: []pipeline.TaskName{FetcherTaskName}},
+	AccountEraSeqCreatorTaskName:       {stage: pipeline.StageSequencer, dependencies: []pipeline.TaskName{FetcherTaskName}},
+	TransactionSeqCreatorTaskName:      {stage: pipeline.StageSequencer, dependencies: []pipeline.TaskName{FetcherTaskName}},
+	RewardEraSeqCreatorTaskName:        {stage: pipeline.StageSequencer, dependencies: []pipeline.TaskName{ValidatorsParserTaskName}},
+	SlashSeqCreatorTaskName:            {stage: pipeline.StageSequencer, dependencies: []pipeline.TaskName{FetcherTaskName}},
+	AccountBalanceSeqCreatorTaskName:   {stage: pipeline.StageSequencer, dependencies: []pipeline.TaskName{FetcherTaskName}},
+
+	ValidatorAggCreatorTaskName: {stage: pipeline.StageAggregator, dependencies: []pipeline.TaskName{ValidatorsParserTaskName}},
+
+	TaskNameEraSystemEventCreator:      {stage: StageAnalyzer, dependencies: []pipeline.TaskName{AccountEraSeqCreatorTaskName, ValidatorEraSeqCreatorTaskName}},
+	TaskNameIdentitySystemEventCreator: {stage: StageAnalyzer, dependencies: []pipeline.TaskName{ValidatorsParserTaskName}},
+	TaskNameRewardReconciler:           {stage: StageAnalyzer, dependencies: []pipeline.TaskName{FetcherTaskName}},
+	TaskNameSessionSystemEventCreator:  {stage: StageAnalyzer, dependencies: []pipeline.TaskName{ValidatorSessionSeqCreatorTaskName}},
+	TaskNameSlashSystemEventCreator:    {stage: StageAnalyzer, dependencies: []pipeline.TaskName{SlashSeqCreatorTaskName}},
+	TaskNameSystemEventCreator:         {stage: StageAnalyzer, dependencies: []pipeline.TaskName{ValidatorSeqCreatorTaskName}},
+
+	SyncerPersistorTaskName:              {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{MainSyncerTaskName}},
+	BlockSeqPersistorTaskName:            {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{BlockSeqCreatorTaskName}},
+	ValidatorSeqPersistorTaskName:        {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{ValidatorSeqCreatorTaskName}},
+	ValidatorSessionSeqPersistorTaskName: {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{ValidatorSessionSeqCreatorTaskName}},
+	ValidatorEraSeqPersistorTaskName:     {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{ValidatorEraSeqCreatorTaskName}},
+	ValidatorAggPersistorTaskName:        {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{ValidatorAggCreatorTaskName}},
+	EventSeqPersistorTaskName:            {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{EventSeqCreatorTaskName}},
+	AccountEraSeqPersistorTaskName:       {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{AccountEraSeqCreatorTaskName}},
+	TransactionSeqPersistorTaskName:      {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{TransactionSeqCreatorTaskName}},
+	SystemEventPersistorTaskName:         {stage: pipeline.StagePersistor},
+	RewardEraSeqPersistorTaskName:        {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{RewardEraSeqCreatorTaskName}},
+	RewardDiscrepancyPersistorTaskName:   {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{TaskNameRewardReconciler}},
+	SlashSeqPersistorTaskName:            {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{SlashSeqCreatorTaskName}},
+	AccountBalanceSeqPersistorTaskName:   {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{AccountBalanceSeqCreatorTaskName}},
+	IdentityPersistorTaskName:            {stage: pipeline.StagePersistor, dependencies: []pipeline.TaskName{ValidatorsParserTaskName}},
+}
+
+// stageUnknown groups tasks which are not registered in pipeline
+const stageUnknown pipeline.StageName = "Unknown"
+
+// pipelineStages are stages in order in which they are run
+var pipelineStages = []pipeline.StageName{
+	pipeline.StageFetcher,
+	pipeline.StageSyncer,
+	pipeline.StageParser,
+	pipeline.StageSequencer,
+	pipeline.StageAggregator,
+	StageAnalyzer,
+	pipeline.StagePersistor,
+}
+
+// ConfigCheckResult contains problems found in indexer config and tasks resolved for its versions and targets
+type ConfigCheckResult struct {
+	Problems []error
+	Versions []VersionTaskGraph
+	Targets  []TargetTaskGraph
+}
+
+// IsValid returns true when no problems were found
+func (r *ConfigCheckResult) IsValid() bool {
+	return len(r.Problems) == 0
+}
+
+type VersionTaskGraph struct {
+	ID        int64
+	Parallel  bool
+	TargetIds []int64
+	Stages    []StageTasks
+}
+
+type TargetTaskGraph struct {
+	ID     int64
+	Name   string
+	Stages []StageTasks
+}
+
+type StageTasks struct {
+	Stage pipeline.StageName
+	Tasks []TaskNode
+}
+
+type TaskNode struct {
+	Name         pipeline.TaskName
+	Dependencies []pipeline.TaskName
+}
+
+// CheckConfig validates indexer config file and resolves tasks of its versions and targets
+func CheckConfig(file string) (*ConfigCheckResult, error) {
+	configParser, err := NewConfigParser(file)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConfigCheckResult{
+		Problems: configParser.Validate(),
+	}
+
+	for _, v := range configParser.targets.Versions {
+		tasks, _ := configParser.GetTasksByTargetIds(configParser.getExistingTargetIds(v.Targets))
+		result.Versions = append(result.Versions, VersionTaskGraph{
+			ID:        v.ID,
+			Parallel:  v.Parallel,
+			TargetIds: v.Targets,
+			Stages:    getStageTasks(tasks),
+		})
+	}
+
+	for _, t := range configParser.targets.AvailableTargets {
+		result.Targets = append(result.Targets, TargetTaskGraph{
+			ID:     t.ID,
+			Name:   t.Name,
+			Stages: getStageTasks(configParser.appendSharedTasks(t.Tasks)),
+		})
+	}
+
+	return result, nil
+}
+
+// Validate returns all problems found in indexer config
+func (o *configParser) Validate() []error {
+	var problems []error
+
+	if len(o.targets.Versions) == 0 {
+		problems = append(problems, errors.New("no versions defined"))
+	}
+
+	for i, v := range o.targets.Versions {
+		if v.ID != int64(i+1) {
+			problems = append(problems, errors.New(fmt.Sprintf("version ids have to be contiguous starting from 1, version at position %d has id %d", i+1, v.ID)))
+		}
+		if len(v.Targets) == 0 {
+			problems = append(problems, errors.New(fmt.Sprintf("version %d has no targets", v.ID)))
+		}
+		for _, targetId := range v.Targets {
+			if o.findTarget(targetId) == nil {
+				problems = append(problems, errors.New(fmt.Sprintf("version %d refers to target %d which does not exist", v.ID, targetId)))
+			}
+		}
+	}
+
+	problems = append(problems, validateTaskNames("shared tasks", o.targets.SharedTasks)...)
+
+	targetIds := make(map[int64]bool)
+	for _, t := range o.targets.AvailableTargets {
+		if targetIds[t.ID] {
+			problems = append(problems, errors.New(fmt.Sprintf("target id %d is used by more than one target", t.ID)))
+		}
+		targetIds[t.ID] = true
+
+		if len(t.Tasks) == 0 {
+			problems = append(problems, errors.New(fmt.Sprintf("target %d (%s) has no tasks", t.ID, t.Name)))
+		}
+
+		name := fmt.Sprintf("target %d (%s)", t.ID, t.Name)
+		problems = append(problems, validateTaskNames(name, t.Tasks)...)
+		problems = append(problems, validateTaskDependencies(name, o.appendSharedTasks(t.Tasks))...)
+	}
+
+	return problems
+}
+
+func (o *configParser) findTarget(targetId int64) *target {
+	for i := range o.targets.AvailableTargets {
+		if o.targets.AvailableTargets[i].ID == targetId {
+			return &o.targets.AvailableTargets[i]
+		}
+	}
+	return nil
+}
+
+// newConfigValidationError creates error describing all problems found in indexer config
+func newConfigValidationError(file string, problems []error) error {
+	msgs := make([]string, len(problems))
+	for i, problem := range problems {
+		msgs[i] = problem.Error()
+	}
+	return errors.New(fmt.Sprintf("indexer config %s is invalid (run indexer_config_check for details): %s", file, strings.Join(msgs, "; ")))
+}
+
+// validateTaskNames checks that tasks are registered in pipeline
+func validateTaskNames(name string, tasks []pipeline.TaskName) []error {
+	var problems []error
+	for _, task := range tasks {
+		if _, ok := pipelineTasks[task]; !ok {
+			problems = append(problems, errors.New(fmt.Sprintf("%s refers to task %s which does not exist in pipeline", name, task)))
+		}
+	}
+	return problems
+}
+
+// validateTaskDependencies checks that tasks which set data read by tasks are included
+func validateTaskDependencies(name string, tasks []pipeline.TaskName) []error {
+	included := make(map[pipeline.TaskName]bool)
+	for _, task := range tasks {
+		included[task] = true
+	}
+
+	var problems []error
+	for _, task := range getUniqueTaskNames(tasks) {
+		for _, dependency := range pipelineTasks[task].dependencies {
+			if !included[dependency] {
+				problems = append(problems, errors.New(fmt.Sprintf("%s has task %s which depends on task %s that is not included", name, task, dependency)))
+			}
+		}
+	}
+	return problems
+}
+
+// getStageTasks groups tasks by stages in order in which they are run
+func getStageTasks(tasks []pipeline.TaskName) []StageTasks {
+	tasksByStage := make(map[pipeline.StageName][]TaskNode)
+	for _, task := range getUniqueTaskNames(tasks) {
+		stage := stageUnknown
+		if t, ok := pipelineTasks[task]; ok {
+			stage = t.stage
+		}
+		tasksByStage[stage] = append(tasksByStage[stage], TaskNode{Name: task, Dependencies: pipelineTasks[task].dependencies})
+	}
+
+	stageNames := make([]pipeline.StageName, 0, len(pipelineStages)+1)
+	stageNames = append(append(stageNames, pipelineStages...), stageUnknown)
+
+	var stages []StageTasks
+	for _, stage := range stageNames {
+		nodes, ok := tasksByStage[stage]
+		if !ok {
+			continue
+		}
+		sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+		stages = append(stages, StageTasks{Stage: stage, Tasks: nodes})
+	}
+	return stages
+}
+
+// getExistingTargetIds filters out ids of targets which do not exist
+func (o *configParser) getExistingTargetIds(targetIds []int64) []int64 {
+	var ids []int64
+	for _, targetId := range targetIds {
+		if o.findTarget(targetId) != nil {
+			ids = append(ids, targetId)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,85 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/polkadothub-indexer/utils/test"
+)
+
+func TestConfigParser_Validate(t *testing.T) {
+	t.Run("returns no problems for indexer config of repository", func(t *testing.T) {
+		parser, err := NewConfigParser("../indexer_config.json")
+		if err != nil {
+			t.Errorf("NewConfigParser should not return error: err=%+v", err)
+			return
+		}
+
+		if problems := parser.Validate(); len(problems) > 0 {
+			t.Errorf("unexpected problems: %v", problems)
+		}
+	})
+
+	t.Run("returns all problems", func(t *testing.T) {
+		fileName := "test_indexer_config.json"
+		var targetsJsonBlob = []byte(`
+			{
+				"versions": [
+					{ "id": 1, "targets": [1, 5] },
+					{ "id": 3, "targets": [2] }
+				],
+				"shared_tasks": ["MainSyncer", "UnknownShared"],
+				"available_targets": [
+					{ "id": 1, "name": "target1", "tasks": ["Fetcher", "ValidatorsParser", "RewardEraSeqCreator", "RewardEraSeqPersistor"] },
+					{ "id": 2, "name": "target2", "tasks": ["Fetcher", "RewardEraSeqCreator", "UnknownTask"] }
+				]
+			}
+		`)
+
+		test.CreateFile(t, fileName, targetsJsonBlob)
+		defer test.CleanUp(t, fileName)
+
+		parser, err := NewConfigParser(fileName)
+		if err != nil {
+			t.Errorf("NewConfigParser should not return error: err=%+v", err)
+			return
+		}
+
+		expectProblems := []string{
+			"version 1 refers to target 5 which does not exist",
+			"version ids have to be contiguous starting from 1, version at position 2 has id 3",
+			"shared tasks refers to task UnknownShared which does not exist in pipeline",
+			"target 2 (target2) refers to task UnknownTask which does not exist in pipeline",
+			"target 2 (target2) has task RewardEraSeqCreator which depends on task ValidatorsParser that is not included",
+		}
+
+		problems := parser.Validate()
+		if len(problems) != len(expectProblems) {
+			t.Errorf("unexpected number of problems, want: %d; got: %d (%v)", len(expectProblems), len(problems), problems)
+			return
+		}
+
+		for i, problem := range problems {
+			if problem.Error() != expectProblems[i] {
+				t.Errorf("unexpected problem, want: %s; got: %s", expectProblems[i], problem)
+			}
+		}
+	})
+}
+
+func TestGetStageTasks(t *testing.T) {
+	stages := getStageTasks([]pipeline.TaskName{RewardEraSeqPersistorTaskName, "UnknownTask", FetcherTaskName, RewardEraSeqCreatorTaskName, ValidatorsParserTaskName})
+
+	expect := []StageTasks{
+		{Stage: pipeline.StageFetcher, Tasks: []TaskNode{{Name: FetcherTaskName}}},
+		{Stage: pipeline.StageParser, Tasks: []TaskNode{{Name: ValidatorsParserTaskName, Dependencies: []pipeline.TaskName{FetcherTaskName}}}},
+		{Stage: pipeline.StageSequencer, Tasks: []TaskNode{{Name: RewardEraSeqCreatorTaskName, Dependencies: []pipeline.TaskName{ValidatorsParserTaskName}}}},
+		{Stage: pipeline.StagePersistor, Tasks: []TaskNode{{Name: RewardEraSeqPersistorTaskName, Dependencies: []pipeline.TaskName{RewardEraSeqCreatorTaskName}}}},
+		{Stage: stageUnknown, Tasks: []TaskNode{{Name: "UnknownTask"}}},
+	}
+
+	if !reflect.DeepEqual(stages, expect) {
+		t.Errorf("unexpected stages, want: %+v; got: %+v", expect, stages)
+	}
+}
@@ -0,0 +1,153 @@
+package indexer
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ErrorClassUnknown ErrorClass = iota
+	ErrorClassNetwork
+	ErrorClassDatabase
+	ErrorClassValidation
+)
+
+var (
+	validationErrors = []error{
+		ErrBlockSequenceNotValid,
+		ErrValidatorSequenceNotValid,
+		ErrValidatorSessionSequenceNotValid,
+		ErrValidatorEraSequenceNotValid,
+		ErrAccountEraSequenceNotValid,
+		ErrEventSequenceNotValid,
+		ErrTransactionSequenceNotValid,
+		ErrActiveBalanceOutsideOfRange,
+		ErrCommissionOutsideOfRange,
+		errUnexpectedTxDataFormat,
+		errUnexpectedEventDataFormat,
+	}
+
+	transientGrpcCodes = map[codes.Code]bool{
+		codes.Unavailable:       true,
+		codes.DeadlineExceeded:  true,
+		codes.ResourceExhausted: true,
+		codes.Aborted:           true,
+	}
+
+	// Postgres error classes and codes which can succeed when retried
+	// See https://www.postgresql.org/docs/current/errcodes-appendix.html
+	transientPostgresErrorClasses = []string{"08", "53", "57P"}
+	transientPostgresErrorCodes   = map[pq.ErrorCode]bool{
+		"40001": true, // serialization_failure
+		"40P01": true, // deadlock_detected
+	}
+)
+
+type ErrorClass int
+
+func (c ErrorClass) String() string {
+	switch c {
+	case ErrorClassNetwork:
+		return "network"
+	case ErrorClassDatabase:
+		return "database"
+	case ErrorClassValidation:
+		return "validation"
+	default:
+		return "unknown"
+	}
+}
+
+// IndexerError is an error with its class attached
+type IndexerError struct {
+	Class ErrorClass
+	Err   error
+}
+
+func (e *IndexerError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *IndexerError) Unwrap() error {
+	return e.Err
+}
+
+// classifyError determines class of error by looking at its chain
+func classifyError(err error) ErrorClass {
+	var indexerErr *IndexerError
+	if errors.As(err, &indexerErr) {
+		return indexerErr.Class
+	}
+
+	for _, validationErr := range validationErrors {
+		if errors.Is(err, validationErr) {
+			return ErrorClassValidation
+		}
+	}
+
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		if transientGrpcCodes[grpcErr.GRPCStatus().Code()] {
+			return ErrorClassNetwork
+		}
+		return ErrorClassUnknown
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		if isTransientPostgresError(pqErr) {
+			return ErrorClassDatabase
+		}
+		return ErrorClassUnknown
+	}
+
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return ErrorClassDatabase
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return ErrorClassNetwork
+	}
+
+	return ErrorClassUnknown
+}
+
+func isTransientPostgresError(err *pq.Error) bool {
+	if transientPostgresErrorCodes[err.Code] {
+		return true
+	}
+	for _, class := range transientPostgresErrorClasses {
+		if strings.HasPrefix(string(err.Code), class) {
+			return true
+		}
+	}
+	return false
+}
+
+// TaskError is an error of task which failed while indexing height
+type TaskError struct {
+	Stage   pipeline.StageName
+	Task    string
+	Height  int64
+	Summary model.FailedHeightPayloadSummary
+	Err     error
+}
+
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("task %s in stage %s failed at height %d: %s", e.Task, e.Stage, e.Height, e.Err.Error())
+}
+
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
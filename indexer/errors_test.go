@@ -0,0 +1,45 @@
+package indexer
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/lib/pq"
+	pkgErrors "github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		expectClass ErrorClass
+	}{
+		{"grpc unavailable", status.Error(codes.Unavailable, "test"), ErrorClassNetwork},
+		{"grpc deadline exceeded", status.Error(codes.DeadlineExceeded, "test"), ErrorClassNetwork},
+		{"grpc invalid argument", status.Error(codes.InvalidArgument, "test"), ErrorClassUnknown},
+		{"wrapped grpc unavailable", pkgErrors.Wrap(status.Error(codes.Unavailable, "test"), "wrapped"), ErrorClassNetwork},
+		{"postgres connection failure", &pq.Error{Code: "08006"}, ErrorClassDatabase},
+		{"postgres deadlock", &pq.Error{Code: "40P01"}, ErrorClassDatabase},
+		{"postgres unique violation", &pq.Error{Code: "23505"}, ErrorClassUnknown},
+		{"bad connection", driver.ErrBadConn, ErrorClassDatabase},
+		{"mapper validation error", ErrBlockSequenceNotValid, ErrorClassValidation},
+		{"wrapped mapper validation error", pkgErrors.Wrap(ErrEventSequenceNotValid, "wrapped"), ErrorClassValidation},
+		{"unexpected data format", errUnexpectedEventDataFormat, ErrorClassValidation},
+		{"indexer error", &IndexerError{Class: ErrorClassDatabase, Err: errors.New("test")}, ErrorClassDatabase},
+		{"reorg error", &ReorgError{Height: 10}, ErrorClassUnknown},
+		{"not found error", store.ErrNotFound, ErrorClassUnknown},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			if class := classifyError(tt.err); class != tt.expectClass {
+				t.Errorf("unexpected class, want: %s; got: %s", tt.expectClass, class)
+			}
+		})
+	}
+}
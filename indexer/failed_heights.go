@@ -0,0 +1,180 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+var (
+	_ pipeline.Task = (*failureTrackingTask)(nil)
+)
+
+// withFailureTracking wraps tasks of given stage so that their errors carry stage, task name and payload summary
+func withFailureTracking(stage pipeline.StageName, tasks ...pipeline.Task) []pipeline.Task {
+	trackedTasks := make([]pipeline.Task, len(tasks))
+	for i, task := range tasks {
+		trackedTasks[i] = &failureTrackingTask{
+			stage: stage,
+			task:  task,
+		}
+	}
+	return trackedTasks
+}
+
+type failureTrackingTask struct {
+	stage pipeline.StageName
+	task  pipeline.Task
+}
+
+func (t *failureTrackingTask) GetName() string {
+	return t.task.GetName()
+}
+
+func (t *failureTrackingTask) Run(ctx context.Context, p pipeline.Payload) error {
+	err := t.task.Run(ctx, p)
+	if err == nil {
+		return nil
+	}
+
+	payload := p.(*payload)
+	return &TaskError{
+		Stage:   t.stage,
+		Task:    t.GetName(),
+		Height:  payload.CurrentHeight,
+		Summary: summarizePayload(payload),
+		Err:     err,
+	}
+}
+
+func summarizePayload(p *payload) model.FailedHeightPayloadSummary {
+	summary := model.FailedHeightPayloadSummary{
+		SpecVersion:       p.HeightMeta.SpecVersion,
+		Session:           p.HeightMeta.Session,
+		Era:               p.HeightMeta.Era,
+		BlockHash:         p.HeightMeta.Hash,
+		EventsCount:       len(p.RawEvents),
+		TransactionsCount: len(p.RawTransactions),
+		ValidatorsCount:   len(p.RawValidators),
+	}
+
+	if !p.HeightMeta.Time.IsZero() {
+		summary.Time = types.NewTimeFromTime(p.HeightMeta.Time.Time)
+	}
+	return summary
+}
+
+// failedHeightRecorder stores heights which failed indexing so that they can be retried later
+type failedHeightRecorder struct {
+	indexVersion   int64
+	failedHeightDb store.FailedHeights
+}
+
+// record stores failure of height. Height has single record, which is reopened when it fails again after being resolved.
+func (r *failedHeightRecorder) record(height int64, pipelineErr error) error {
+	failedHeight, err := r.failedHeightDb.FindByHeight(height)
+	if err != nil {
+		if err != store.ErrNotFound {
+			return err
+		}
+		failedHeight = &model.FailedHeight{Height: height}
+	}
+
+	failedHeight.IndexVersion = r.indexVersion
+	failedHeight.ErrorClass = classifyError(pipelineErr).String()
+	failedHeight.ErrorMsg = pipelineErr.Error()
+	failedHeight.Attempts += 1
+	failedHeight.ResolvedAt = nil
+
+	var taskErr *TaskError
+	if errors.As(pipelineErr, &taskErr) {
+		summary, err := json.Marshal(taskErr.Summary)
+		if err != nil {
+			return err
+		}
+
+		failedHeight.Stage = string(taskErr.Stage)
+		failedHeight.Task = taskErr.Task
+		failedHeight.PayloadSummary = types.Jsonb{RawMessage: summary}
+	}
+
+	logger.Info(fmt.Sprintf("recording failed height [height=%d] [stage=%s] [task=%s] [class=%s]", height, failedHeight.Stage, failedHeight.Task, failedHeight.ErrorClass))
+
+	if failedHeight.Model == nil {
+		return r.failedHeightDb.Create(failedHeight)
+	}
+	return r.failedHeightDb.Save(failedHeight)
+}
+
+// RetryFailed re-runs unresolved failed heights for targets affected by failure
+func (p *indexingPipeline) RetryFailed(ctx context.Context) error {
+	failedHeights, err := p.failedHeightDb.FindUnresolved()
+	if err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("retrying failed heights [count=%d]", len(failedHeights)))
+
+	defer p.closePayloadSinks()
+
+	var successCount int64
+
+	for i := range failedHeights {
+		failedHeight := &failedHeights[i]
+
+		runCfg := p.getRetryRunConfig(failedHeight)
+
+		runPayload, err := p.Run(ctx, runCfg)
+		if err != nil {
+			if err := p.failedHeightRecorder.record(failedHeight.Height, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		targetIds := runCfg.DesiredTargetIDs
+		if len(targetIds) == 0 {
+			targetIds = p.configParser.GetAllVersionedTargetIds()
+		}
+
+		sink := NewSink(p.databaseDb, p.syncableDb, p.targetRangeDb, p.configParser.GetCurrentVersionId(), targetIds)
+		if err := p.withPayloadSinks(sink).Consume(ctx, runPayload); err != nil {
+			return err
+		}
+
+		successCount++
+
+		failedHeight.Resolve()
+		if err := p.failedHeightDb.Save(failedHeight); err != nil {
+			return err
+		}
+	}
+
+	logger.Info(fmt.Sprintf("failed heights retried [success=%d] [total=%d]", successCount, len(failedHeights)))
+
+	return nil
+}
+
+// getRetryRunConfig returns run config with targets affected by failure.
+// When height failed before persistor stage nothing was persisted for it, so all targets have to be re-run.
+func (p *indexingPipeline) getRetryRunConfig(failedHeight *model.FailedHeight) RunConfig {
+	runCfg := RunConfig{
+		Height: failedHeight.Height,
+	}
+
+	if failedHeight.Stage == string(pipeline.StagePersistor) {
+		runCfg.DesiredTargetIDs = p.configParser.GetTargetIdsByTaskName(pipeline.TaskName(failedHeight.Task))
+	}
+
+	if len(runCfg.DesiredTargetIDs) == 0 {
+		runCfg.DesiredVersionIDs = p.configParser.GetAllVersionedVersionIds()
+	}
+	return runCfg
+}
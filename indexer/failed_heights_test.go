@@ -0,0 +1,238 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	mockIndexer "github.com/figment-networks/polkadothub-indexer/mock/indexer"
+	mock "github.com/figment-networks/polkadothub-indexer/mock/store"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFailureTrackingTask_Run(t *testing.T) {
+	t.Run("returns nil when task succeeds", func(t *testing.T) {
+		tasks := withFailureTracking(pipeline.StageFetcher, &failingTask{})
+
+		if err := tasks[0].Run(context.Background(), &payload{}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("returns task error with payload summary when task fails", func(t *testing.T) {
+		testErr := errors.New("test")
+		tasks := withFailureTracking(pipeline.StageParser, &failingTask{errs: []error{testErr}})
+
+		p := &payload{
+			CurrentHeight: 20,
+			HeightMeta:    HeightMeta{Hash: "0xabc", SpecVersion: "25", Session: 3, Era: 1},
+		}
+
+		err := tasks[0].Run(context.Background(), p)
+
+		var taskErr *TaskError
+		if !errors.As(err, &taskErr) {
+			t.Errorf("want TaskError; got %v", err)
+			return
+		}
+		if !errors.Is(err, testErr) {
+			t.Errorf("task error should wrap original error")
+		}
+		if taskErr.Stage != pipeline.StageParser || taskErr.Task != "FailingTask" || taskErr.Height != 20 {
+			t.Errorf("unexpected task error, got: %+v", taskErr)
+		}
+
+		expectSummary := model.FailedHeightPayloadSummary{SpecVersion: "25", Session: 3, Era: 1, BlockHash: "0xabc"}
+		if !reflect.DeepEqual(taskErr.Summary, expectSummary) {
+			t.Errorf("unexpected summary, want: %+v; got: %+v", expectSummary, taskErr.Summary)
+		}
+	})
+}
+
+func TestFailedHeightRecorder_record(t *testing.T) {
+	taskErr := &TaskError{
+		Stage:   pipeline.StagePersistor,
+		Task:    "BlockSeqPersistor",
+		Height:  20,
+		Summary: model.FailedHeightPayloadSummary{BlockHash: "0xabc"},
+		Err:     status.Error(codes.Unavailable, "test"),
+	}
+
+	t.Run("creates new failed height", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dbMock := mock.NewMockFailedHeights(ctrl)
+		dbMock.EXPECT().FindByHeight(int64(20)).Return(nil, store.ErrNotFound).Times(1)
+		dbMock.EXPECT().Create(gomock.Any()).DoAndReturn(func(record interface{}) error {
+			failedHeight := record.(*model.FailedHeight)
+			if failedHeight.Height != 20 || failedHeight.IndexVersion != 2 || failedHeight.Attempts != 1 {
+				t.Errorf("unexpected failed height: %+v", failedHeight)
+			}
+			if failedHeight.Stage != string(pipeline.StagePersistor) || failedHeight.Task != "BlockSeqPersistor" {
+				t.Errorf("unexpected stage or task, got: %s %s", failedHeight.Stage, failedHeight.Task)
+			}
+			if failedHeight.ErrorClass != ErrorClassNetwork.String() {
+				t.Errorf("unexpected error class, want: %s; got: %s", ErrorClassNetwork, failedHeight.ErrorClass)
+			}
+
+			var summary model.FailedHeightPayloadSummary
+			if err := json.Unmarshal(failedHeight.PayloadSummary.RawMessage, &summary); err != nil || summary.BlockHash != "0xabc" {
+				t.Errorf("unexpected payload summary: %s", failedHeight.PayloadSummary.RawMessage)
+			}
+			return nil
+		}).Times(1)
+
+		recorder := failedHeightRecorder{indexVersion: 2, failedHeightDb: dbMock}
+		if err := recorder.record(20, taskErr); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("updates existing failed height", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		existing := &model.FailedHeight{Model: &model.Model{ID: 1}, Height: 20, Attempts: 2}
+
+		dbMock := mock.NewMockFailedHeights(ctrl)
+		dbMock.EXPECT().FindByHeight(int64(20)).Return(existing, nil).Times(1)
+		dbMock.EXPECT().Save(existing).Return(nil).Times(1)
+
+		recorder := failedHeightRecorder{indexVersion: 2, failedHeightDb: dbMock}
+		if err := recorder.record(20, taskErr); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if existing.Attempts != 3 {
+			t.Errorf("unexpected attempts, want: %d; got: %d", 3, existing.Attempts)
+		}
+	})
+
+	t.Run("reopens resolved failed height", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		existing := &model.FailedHeight{Model: &model.Model{ID: 1}, Height: 20, Attempts: 1}
+		existing.Resolve()
+
+		dbMock := mock.NewMockFailedHeights(ctrl)
+		dbMock.EXPECT().FindByHeight(int64(20)).Return(existing, nil).Times(1)
+		dbMock.EXPECT().Save(existing).Return(nil).Times(1)
+
+		recorder := failedHeightRecorder{indexVersion: 2, failedHeightDb: dbMock}
+		if err := recorder.record(20, taskErr); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if existing.ResolvedAt != nil {
+			t.Errorf("failed height should not be resolved")
+		}
+	})
+
+	t.Run("returns error when lookup fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dbErr := errors.New("test")
+
+		dbMock := mock.NewMockFailedHeights(ctrl)
+		dbMock.EXPECT().FindByHeight(int64(20)).Return(nil, dbErr).Times(1)
+
+		recorder := failedHeightRecorder{indexVersion: 2, failedHeightDb: dbMock}
+		if err := recorder.record(20, taskErr); err != dbErr {
+			t.Errorf("unexpected error, want: %v; got: %v", dbErr, err)
+		}
+	})
+}
+
+func TestIndexingPipeline_getRetryRunConfig(t *testing.T) {
+	t.Run("re-runs only targets of failed persistor task", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		configParserMock := mockIndexer.NewMockConfigParser(ctrl)
+		configParserMock.EXPECT().GetTargetIdsByTaskName(pipeline.TaskName("BlockSeqPersistor")).Return([]int64{1}).Times(1)
+
+		p := &indexingPipeline{configParser: configParserMock}
+
+		runCfg := p.getRetryRunConfig(&model.FailedHeight{Height: 20, Stage: string(pipeline.StagePersistor), Task: "BlockSeqPersistor"})
+		if !reflect.DeepEqual(runCfg.DesiredTargetIDs, []int64{1}) || runCfg.DesiredVersionIDs != nil {
+			t.Errorf("unexpected run config: %+v", runCfg)
+		}
+	})
+
+	t.Run("re-runs all targets when height failed before persistor stage", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		configParserMock := mockIndexer.NewMockConfigParser(ctrl)
+		configParserMock.EXPECT().GetAllVersionedVersionIds().Return([]int64{1, 2}).Times(1)
+
+		p := &indexingPipeline{configParser: configParserMock}
+
+		runCfg := p.getRetryRunConfig(&model.FailedHeight{Height: 20, Stage: string(pipeline.StageFetcher), Task: "HeightMetaRetriever"})
+		if !reflect.DeepEqual(runCfg.DesiredVersionIDs, []int64{1, 2}) || runCfg.DesiredTargetIDs != nil {
+			t.Errorf("unexpected run config: %+v", runCfg)
+		}
+		if runCfg.Height != 20 {
+			t.Errorf("unexpected height, want: %d; got: %d", 20, runCfg.Height)
+		}
+	})
+}
+
+func TestIndexingPipeline_handleFailedHeight(t *testing.T) {
+	validationErr := &TaskError{Stage: pipeline.StageSequencer, Task: "BlockSeqCreator", Height: 20, Err: ErrBlockSequenceNotValid}
+	networkErr := &TaskError{Stage: pipeline.StageFetcher, Task: "Fetcher", Height: 20, Err: &IndexerError{Class: ErrorClassNetwork, Err: status.Error(codes.Unavailable, "test")}}
+
+	tests := []struct {
+		description string
+		err         error
+		skipFailed  bool
+		cancelled   bool
+		expectSaved bool
+		expectNext  int64
+		expectErr   error
+	}{
+		{description: "records and skips height which failed with non-transient error", err: validationErr, skipFailed: true, expectSaved: true, expectNext: 21},
+		{description: "records and returns non-transient error when heights are not skipped", err: validationErr, expectSaved: true, expectErr: validationErr},
+		{description: "does not record nor skip height which failed with transient error", err: networkErr, skipFailed: true, expectErr: networkErr},
+		{description: "does not record nor skip height interrupted by shutdown", err: validationErr, skipFailed: true, cancelled: true, expectErr: validationErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dbMock := mock.NewMockFailedHeights(ctrl)
+			if tt.expectSaved {
+				dbMock.EXPECT().FindByHeight(int64(20)).Return(nil, store.ErrNotFound).Times(1)
+				dbMock.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+			}
+
+			p := &indexingPipeline{
+				failedHeightRecorder: &failedHeightRecorder{failedHeightDb: dbMock},
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancelled {
+				cancel()
+			}
+
+			next, err := p.handleFailedHeight(ctx, 20, tt.err, tt.skipFailed)
+			if err != tt.expectErr {
+				t.Errorf("unexpected error, want: %v; got: %v", tt.expectErr, err)
+			}
+			if next != tt.expectNext {
+				t.Errorf("unexpected next height, want: %d; got: %d", tt.expectNext, next)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package indexer
+
+import (
+	"sync"
+
+	"github.com/figment-networks/polkadothub-proxy/grpc/height/heightpb"
+)
+
+var (
+	_ FetcherClient = (*prefetchingClient)(nil)
+)
+
+// NewPrefetchingClient creates FetcherClient which concurrently fetches up to windowSize heights
+// following the one requested. Heights are never prefetched beyond max height.
+func NewPrefetchingClient(client FetcherClient, windowSize int64) *prefetchingClient {
+	return &prefetchingClient{
+		client:     client,
+		windowSize: windowSize,
+		prefetched: map[int64]*prefetchResult{},
+	}
+}
+
+type prefetchingClient struct {
+	client     FetcherClient
+	windowSize int64
+
+	mu         sync.Mutex
+	maxHeight  int64
+	prefetched map[int64]*prefetchResult
+}
+
+type prefetchResult struct {
+	done chan struct{}
+	resp *heightpb.GetAllResponse
+	err  error
+}
+
+// GetAll returns prefetched response for height when available and schedules fetching of following heights.
+// Failed prefetches are fetched again so that retries behave same as without prefetching.
+func (c *prefetchingClient) GetAll(height int64) (*heightpb.GetAllResponse, error) {
+	c.mu.Lock()
+	res, ok := c.prefetched[height]
+	c.evict(height)
+	c.schedule(height)
+	c.mu.Unlock()
+
+	if ok {
+		<-res.done
+		if res.err == nil {
+			return res.resp, nil
+		}
+	}
+
+	return c.client.GetAll(height)
+}
+
+// setMaxHeight sets last height which is allowed to be prefetched
+func (c *prefetchingClient) setMaxHeight(height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.maxHeight = height
+}
+
+// evict removes results which are outside of window following given height
+func (c *prefetchingClient) evict(height int64) {
+	for h := range c.prefetched {
+		if h <= height || h > height+c.windowSize {
+			delete(c.prefetched, h)
+		}
+	}
+}
+
+func (c *prefetchingClient) schedule(height int64) {
+	for h := height + 1; h <= height+c.windowSize && h <= c.maxHeight; h++ {
+		if _, ok := c.prefetched[h]; ok {
+			continue
+		}
+
+		res := &prefetchResult{done: make(chan struct{})}
+		c.prefetched[h] = res
+
+		go func(h int64) {
+			defer close(res.done)
+			res.resp, res.err = c.client.GetAll(h)
+		}(h)
+	}
+}
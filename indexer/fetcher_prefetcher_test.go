@@ -0,0 +1,88 @@
+package indexer
+
+import (
+	"errors"
+	"testing"
+
+	mock "github.com/figment-networks/polkadothub-indexer/mock/indexer"
+	"github.com/figment-networks/polkadothub-proxy/grpc/block/blockpb"
+	"github.com/figment-networks/polkadothub-proxy/grpc/height/heightpb"
+	"github.com/golang/mock/gomock"
+)
+
+func TestPrefetchingClient_GetAll(t *testing.T) {
+	responseAt := func(height int64) *heightpb.GetAllResponse {
+		return &heightpb.GetAllResponse{Block: &blockpb.GetByHeightResponse{Block: &blockpb.Block{Header: &blockpb.Header{Height: height}}}}
+	}
+
+	t.Run("prefetches heights within window and max height", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock.NewMockFetcherClient(ctrl)
+
+		for h := int64(10); h <= 12; h++ {
+			clientMock.EXPECT().GetAll(h).Return(responseAt(h), nil).Times(1)
+		}
+
+		prefetcher := NewPrefetchingClient(clientMock, 5)
+		prefetcher.setMaxHeight(12)
+
+		for h := int64(10); h <= 12; h++ {
+			resp, err := prefetcher.GetAll(h)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if resp.GetBlock().GetBlock().GetHeader().GetHeight() != h {
+				t.Errorf("unexpected response height, want: %d; got: %d", h, resp.GetBlock().GetBlock().GetHeader().GetHeight())
+			}
+		}
+	})
+
+	t.Run("fetches height again when prefetch failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock.NewMockFetcherClient(ctrl)
+
+		clientMock.EXPECT().GetAll(int64(10)).Return(responseAt(10), nil).Times(1)
+		gomock.InOrder(
+			clientMock.EXPECT().GetAll(int64(11)).Return(nil, errors.New("test error")).Times(1),
+			clientMock.EXPECT().GetAll(int64(11)).Return(responseAt(11), nil).Times(1),
+		)
+
+		prefetcher := NewPrefetchingClient(clientMock, 1)
+		prefetcher.setMaxHeight(11)
+
+		if _, err := prefetcher.GetAll(10); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		resp, err := prefetcher.GetAll(11)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.GetBlock().GetBlock().GetHeader().GetHeight() != 11 {
+			t.Errorf("unexpected response height, want: %d; got: %d", 11, resp.GetBlock().GetBlock().GetHeader().GetHeight())
+		}
+	})
+
+	t.Run("does not prefetch when window size is 0", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock.NewMockFetcherClient(ctrl)
+
+		clientMock.EXPECT().GetAll(int64(10)).Return(responseAt(10), nil).Times(1)
+
+		prefetcher := NewPrefetchingClient(clientMock, 0)
+		prefetcher.setMaxHeight(20)
+
+		if _, err := prefetcher.GetAll(10); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
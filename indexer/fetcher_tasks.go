@@ -22,6 +22,8 @@ const (
 type HeightMeta struct {
 	Height        int64
 	Time          types.Time
+	Hash          string
+	ParentHash    string
 	SpecVersion   string
 	ChainUID      string
 	Session       int64
@@ -77,6 +79,8 @@ func (t *FetcherTask) Run(ctx context.Context, p pipeline.Payload) error {
 	payload.HeightMeta = HeightMeta{
 		Height:        payload.CurrentHeight,
 		Time:          *types.NewTimeFromTimestamp(*meta.GetTime()),
+		Hash:          payload.RawBlock.GetBlockHash(),
+		ParentHash:    payload.RawBlock.GetHeader().GetParentHash(),
 		ChainUID:      meta.GetChain(),
 		SpecVersion:   meta.GetSpecVersion(),
 		Session:       meta.GetSession(),
@@ -43,6 +43,8 @@ func TestFetcher_Run(t *testing.T) {
 	expectHeightMeta := HeightMeta{
 		Height:        20,
 		Time:          *types.NewTimeFromTimestamp(*expectTimeStamp),
+		Hash:          "hkasdbbjsd",
+		ParentHash:    "aknsdkjwe",
 		SpecVersion:   "v1.0",
 		ChainUID:      "chain123",
 		Session:       1,
@@ -50,7 +52,7 @@ func TestFetcher_Run(t *testing.T) {
 		LastInSession: false,
 		LastInEra:     true,
 	}
-	expectBlock := &blockpb.Block{BlockHash: "hkasdbbjsd"}
+	expectBlock := &blockpb.Block{BlockHash: "hkasdbbjsd", Header: &blockpb.Header{ParentHash: "aknsdkjwe"}}
 	expectRawValidatorPerformance := []*validatorperformancepb.Validator{{StashAccount: "stash1"}}
 	expectRawStaking := &stakingpb.Staking{Session: 5, Era: 6}
 	expectRawEvents := []*eventpb.Event{{Method: "staking"}}
@@ -0,0 +1,117 @@
+package indexer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+	"github.com/figment-networks/polkadothub-proxy/grpc/event/eventpb"
+)
+
+const (
+	sectionIdentity = "identity"
+)
+
+var (
+	_ IdentityResolver = (*identityResolver)(nil)
+)
+
+type IdentityResolver interface {
+	GetDisplayNames(syncable *model.Syncable, stashAccounts []string, events []*eventpb.Event) (map[string]string, []model.Identity, []model.IdentityChange, error)
+}
+
+// NewIdentityResolver creates resolver which keeps identities in cache and refreshes them
+// when identity events of account are found at height or when they are older than ttl
+func NewIdentityResolver(accountClient client.AccountClient, identityDb store.Identities, ttl time.Duration) *identityResolver {
+	return &identityResolver{
+		accountClient: accountClient,
+		identityDb:    identityDb,
+		ttl:           ttl,
+	}
+}
+
+type identityResolver struct {
+	accountClient client.AccountClient
+	identityDb    store.Identities
+	ttl           time.Duration
+}
+
+// GetDisplayNames returns display names of accounts. Resolver does not write to cache, refreshed identities and
+// changes of display names found at height of syncable are returned to be persisted by IdentityPersistor.
+// Identities can only be fetched at chain head, so changes are not recorded for heights older than ttl.
+func (r *identityResolver) GetDisplayNames(syncable *model.Syncable, stashAccounts []string, events []*eventpb.Event) (map[string]string, []model.Identity, []model.IdentityChange, error) {
+	identities, err := r.identityDb.FindByStashAccounts(stashAccounts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	cached := make(map[string]model.Identity, len(identities))
+	for _, identity := range identities {
+		cached[identity.StashAccount] = identity
+	}
+
+	changedAccounts := getIdentityEventAccounts(events)
+	isRecent := time.Since(syncable.Time.Time) <= r.ttl
+
+	displayNames := make(map[string]string, len(stashAccounts))
+	var refreshed []model.Identity
+	var changes []model.IdentityChange
+	for _, stashAccount := range stashAccounts {
+		identity, ok := cached[stashAccount]
+		if ok && (!isRecent || !changedAccounts[stashAccount] && !identity.IsStale(r.ttl)) {
+			displayNames[stashAccount] = identity.DisplayName
+			continue
+		}
+
+		res, err := r.accountClient.GetIdentity(stashAccount)
+		if err != nil {
+			if !ok {
+				return nil, nil, nil, err
+			}
+			logger.Info(fmt.Sprintf("could not refresh identity, using cached one [stash_account=%s] [err=%v]", stashAccount, err))
+			displayNames[stashAccount] = identity.DisplayName
+			continue
+		}
+		displayName := strings.TrimSpace(res.GetIdentity().GetDisplayName())
+		displayNames[stashAccount] = displayName
+
+		refreshed = append(refreshed, model.Identity{
+			StashAccount: stashAccount,
+			DisplayName:  displayName,
+			RefreshedAt:  *types.NewTimeFromTime(time.Now()),
+		})
+
+		if isRecent && (!ok && displayName != "" || ok && displayName != identity.DisplayName) {
+			changes = append(changes, model.IdentityChange{
+				Height:              syncable.Height,
+				Time:                syncable.Time,
+				StashAccount:        stashAccount,
+				DisplayName:         displayName,
+				PreviousDisplayName: identity.DisplayName,
+			})
+		}
+	}
+
+	return displayNames, refreshed, changes, nil
+}
+
+// getIdentityEventAccounts returns accounts found in data of identity events
+func getIdentityEventAccounts(events []*eventpb.Event) map[string]bool {
+	accounts := make(map[string]bool)
+	for _, event := range events {
+		if event.GetSection() != sectionIdentity {
+			continue
+		}
+		for _, d := range event.GetData() {
+			if d.GetName() == accountKey {
+				accounts[d.GetValue()] = true
+			}
+		}
+	}
+	return accounts
+}
@@ -0,0 +1,186 @@
+package indexer
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	mock_client "github.com/figment-networks/polkadothub-indexer/mock/client"
+	mock "github.com/figment-networks/polkadothub-indexer/mock/store"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/polkadothub-proxy/grpc/event/eventpb"
+	"github.com/golang/mock/gomock"
+)
+
+func TestIdentityResolver_GetDisplayNames(t *testing.T) {
+	syncable := &model.Syncable{Height: 100, Time: *types.NewTimeFromTime(time.Now())}
+	fresh := *types.NewTimeFromTime(time.Now())
+	stale := *types.NewTimeFromTime(time.Now().Add(-2 * time.Hour))
+
+	identityResponse := func(displayName string) *accountpb.GetIdentityResponse {
+		return &accountpb.GetIdentityResponse{Identity: &accountpb.AccountIdentity{DisplayName: displayName}}
+	}
+
+	t.Run("uses fresh cached identities without fetching them", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock_client.NewMockAccountClient(ctrl)
+		identityDbMock := mock.NewMockIdentities(ctrl)
+
+		identityDbMock.EXPECT().FindByStashAccounts([]string{"stash1"}).Return([]model.Identity{{StashAccount: "stash1", DisplayName: "name1", RefreshedAt: fresh}}, nil).Times(1)
+		clientMock.EXPECT().GetIdentity(gomock.Any()).Times(0)
+
+		resolver := NewIdentityResolver(clientMock, identityDbMock, time.Hour)
+
+		names, identities, changes, err := resolver.GetDisplayNames(syncable, []string{"stash1"}, nil)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if !reflect.DeepEqual(names, map[string]string{"stash1": "name1"}) {
+			t.Errorf("unexpected display names: %v", names)
+		}
+		if len(identities) != 0 {
+			t.Errorf("unexpected refreshed identities: %v", identities)
+		}
+		if len(changes) != 0 {
+			t.Errorf("unexpected identity changes: %v", changes)
+		}
+	})
+
+	t.Run("fetches identities which are not cached, stale or changed by identity events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock_client.NewMockAccountClient(ctrl)
+		identityDbMock := mock.NewMockIdentities(ctrl)
+
+		identityDbMock.EXPECT().FindByStashAccounts(gomock.Any()).Return([]model.Identity{
+			{StashAccount: "stale", DisplayName: "old", RefreshedAt: stale},
+			{StashAccount: "changed", DisplayName: "same", RefreshedAt: fresh},
+		}, nil).Times(1)
+
+		clientMock.EXPECT().GetIdentity("new").Return(identityResponse(" new name "), nil).Times(1)
+		clientMock.EXPECT().GetIdentity("stale").Return(identityResponse("renamed"), nil).Times(1)
+		clientMock.EXPECT().GetIdentity("changed").Return(identityResponse("same"), nil).Times(1)
+
+		events := []*eventpb.Event{{Section: sectionIdentity, Method: "IdentitySet", Data: []*eventpb.EventData{{Name: accountKey, Value: "changed"}}}}
+
+		resolver := NewIdentityResolver(clientMock, identityDbMock, time.Hour)
+
+		names, identities, changes, err := resolver.GetDisplayNames(syncable, []string{"new", "stale", "changed"}, events)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		expect := map[string]string{"new": "new name", "stale": "renamed", "changed": "same"}
+		if !reflect.DeepEqual(names, expect) {
+			t.Errorf("unexpected display names, want: %v; got: %v", expect, names)
+		}
+
+		var refreshedAccounts []string
+		for _, identity := range identities {
+			if identity.IsStale(time.Hour) {
+				t.Errorf("refreshed identity is stale: %v", identity)
+			}
+			refreshedAccounts = append(refreshedAccounts, identity.StashAccount+"="+identity.DisplayName)
+		}
+		expectRefreshed := []string{"new=new name", "stale=renamed", "changed=same"}
+		if !reflect.DeepEqual(refreshedAccounts, expectRefreshed) {
+			t.Errorf("unexpected refreshed identities, want: %v; got: %v", expectRefreshed, refreshedAccounts)
+		}
+
+		expectChanges := []model.IdentityChange{
+			{Height: 100, Time: syncable.Time, StashAccount: "new", DisplayName: "new name"},
+			{Height: 100, Time: syncable.Time, StashAccount: "stale", DisplayName: "renamed", PreviousDisplayName: "old"},
+		}
+		if !reflect.DeepEqual(changes, expectChanges) {
+			t.Errorf("unexpected identity changes, want: %v; got: %v", expectChanges, changes)
+		}
+	})
+
+	t.Run("does not record changes at heights older than ttl", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock_client.NewMockAccountClient(ctrl)
+		identityDbMock := mock.NewMockIdentities(ctrl)
+
+		identityDbMock.EXPECT().FindByStashAccounts(gomock.Any()).Return([]model.Identity{{StashAccount: "stale", DisplayName: "old", RefreshedAt: stale}}, nil).Times(1)
+		clientMock.EXPECT().GetIdentity("new").Return(identityResponse("new name"), nil).Times(1)
+		clientMock.EXPECT().GetIdentity("stale").Times(0)
+
+		events := []*eventpb.Event{{Section: sectionIdentity, Method: "IdentitySet", Data: []*eventpb.EventData{{Name: accountKey, Value: "stale"}}}}
+		historical := &model.Syncable{Height: 10, Time: *types.NewTimeFromTime(time.Now().Add(-48 * time.Hour))}
+
+		resolver := NewIdentityResolver(clientMock, identityDbMock, time.Hour)
+
+		names, identities, changes, err := resolver.GetDisplayNames(historical, []string{"new", "stale"}, events)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		expect := map[string]string{"new": "new name", "stale": "old"}
+		if !reflect.DeepEqual(names, expect) {
+			t.Errorf("unexpected display names, want: %v; got: %v", expect, names)
+		}
+		if len(identities) != 1 || identities[0].StashAccount != "new" {
+			t.Errorf("unexpected refreshed identities: %v", identities)
+		}
+		if len(changes) != 0 {
+			t.Errorf("unexpected identity changes: %v", changes)
+		}
+	})
+
+	t.Run("uses cached identity when it cannot be refreshed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock_client.NewMockAccountClient(ctrl)
+		identityDbMock := mock.NewMockIdentities(ctrl)
+
+		identityDbMock.EXPECT().FindByStashAccounts(gomock.Any()).Return([]model.Identity{{StashAccount: "stale", DisplayName: "old", RefreshedAt: stale}}, nil).Times(1)
+		clientMock.EXPECT().GetIdentity("stale").Return(nil, errors.New("test error")).Times(1)
+
+		resolver := NewIdentityResolver(clientMock, identityDbMock, time.Hour)
+
+		names, identities, changes, err := resolver.GetDisplayNames(syncable, []string{"stale"}, nil)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if names["stale"] != "old" {
+			t.Errorf("unexpected display name: %s", names["stale"])
+		}
+		if len(identities) != 0 {
+			t.Errorf("unexpected refreshed identities: %v", identities)
+		}
+		if len(changes) != 0 {
+			t.Errorf("unexpected identity changes: %v", changes)
+		}
+	})
+
+	t.Run("returns error when identity which is not cached cannot be fetched", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock_client.NewMockAccountClient(ctrl)
+		identityDbMock := mock.NewMockIdentities(ctrl)
+
+		testErr := errors.New("test error")
+		identityDbMock.EXPECT().FindByStashAccounts(gomock.Any()).Return(nil, nil).Times(1)
+		clientMock.EXPECT().GetIdentity("new").Return(nil, testErr).Times(1)
+
+		resolver := NewIdentityResolver(clientMock, identityDbMock, time.Hour)
+
+		if _, _, _, err := resolver.GetDisplayNames(syncable, []string{"new"}, nil); err != testErr {
+			t.Errorf("unexpected error, want: %v; got: %v", testErr, err)
+		}
+	})
+}
@@ -1,11 +1,15 @@
 package indexer
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"strings"
+	"unicode"
 
 	"github.com/figment-networks/polkadothub-indexer/model"
 	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-proxy/grpc/account/accountpb"
 	"github.com/figment-networks/polkadothub-proxy/grpc/block/blockpb"
 	"github.com/figment-networks/polkadothub-proxy/grpc/event/eventpb"
 	"github.com/figment-networks/polkadothub-proxy/grpc/staking/stakingpb"
@@ -22,6 +26,19 @@ var (
 	ErrAccountEraSequenceNotValid       = errors.New("account era sequence not valid")
 	ErrEventSequenceNotValid            = errors.New("event sequence not valid")
 	ErrTransactionSequenceNotValid      = errors.New("transaction sequence not valid")
+	ErrSlashSequenceNotValid            = errors.New("slash sequence not valid")
+	ErrAccountBalanceSequenceNotValid   = errors.New("account balance sequence not valid")
+)
+
+const (
+	sectionBalances  = "balances"
+	sectionImOnline  = "imOnline"
+	sectionOffences  = "offences"
+	eventMethodSlash = "Slash"
+	// Slashed replaces Slash event in newer runtimes
+	eventMethodSlashed     = "Slashed"
+	eventMethodOffence     = "Offence"
+	eventMethodSomeOffline = "SomeOffline"
 )
 
 func ToBlockSequence(syncable *model.Syncable, rawBlock *blockpb.Block, blockParsedData ParsedBlockData) (*model.BlockSeq, error) {
@@ -207,6 +224,54 @@ func ToAccountEraSequence(syncable *model.Syncable, firstHeight int64, rawStakin
 	return accountEraSeqs, nil
 }
 
+func ToAccountBalanceSequence(syncable *model.Syncable, stashAccount string, rawAccount *accountpb.Account) (model.AccountBalanceSeq, error) {
+	e := model.AccountBalanceSeq{
+		Sequence: &model.Sequence{
+			Height: syncable.Height,
+			Time:   syncable.Time,
+		},
+		StashAccount:    stashAccount,
+		Nonce:           rawAccount.GetNonce(),
+		ReferendumCount: rawAccount.GetReferendumCount(),
+	}
+
+	var err error
+	if e.Free, err = types.NewQuantityFromString(rawAccount.GetFree()); err != nil {
+		return e, err
+	}
+	if e.Reserved, err = types.NewQuantityFromString(rawAccount.GetReserved()); err != nil {
+		return e, err
+	}
+	if e.MiscFrozen, err = types.NewQuantityFromString(rawAccount.GetMiscFrozen()); err != nil {
+		return e, err
+	}
+	if e.FeeFrozen, err = types.NewQuantityFromString(rawAccount.GetFeeFrozen()); err != nil {
+		return e, err
+	}
+
+	if !e.Valid() {
+		return e, ErrAccountBalanceSequenceNotValid
+	}
+
+	return e, nil
+}
+
+// getBalanceEventAccounts returns accounts found in data of balances and staking events
+func getBalanceEventAccounts(events []*eventpb.Event) []string {
+	var accounts []string
+	for _, event := range events {
+		if event.GetSection() != sectionBalances && event.GetSection() != sectionStaking {
+			continue
+		}
+		for _, d := range event.GetData() {
+			if d.GetName() == accountKey {
+				accounts = append(accounts, d.GetValue())
+			}
+		}
+	}
+	return accounts
+}
+
 func ToTransactionSequence(syncable *model.Syncable, rawTransactions []*transactionpb.Annotated) ([]model.TransactionSeq, error) {
 	var transactions []model.TransactionSeq
 
@@ -236,3 +301,160 @@ func ToTransactionSequence(syncable *model.Syncable, rawTransactions []*transact
 	}
 	return transactions, nil
 }
+
+// ToSlashSequences maps slash, offence and offline events to slash sequences.
+// Slash events of nominators follow slash event of their validator, so nominators are attributed to last slashed validator.
+// Validators are recognized by exposures of era in which slashes were reported, slashed accounts which are not exposed
+// to last slashed validator are attributed to themselves.
+func ToSlashSequences(syncable *model.Syncable, rawEvents []*eventpb.Event, slashEraStaking *stakingpb.Staking) ([]model.SlashSeq, error) {
+	stakersByValidator := make(map[string]map[string]bool)
+	for _, rawValidator := range slashEraStaking.GetValidators() {
+		stakers := make(map[string]bool)
+		for _, rawStaker := range rawValidator.GetStakers() {
+			stakers[rawStaker.GetStashAccount()] = true
+		}
+		stakersByValidator[rawValidator.GetStashAccount()] = stakers
+	}
+
+	var slashSeqs []model.SlashSeq
+	var lastValidator string
+
+	for _, rawEvent := range rawEvents {
+		seq := model.SlashSeq{
+			Sequence: &model.Sequence{
+				Height: syncable.Height,
+				Time:   syncable.Time,
+			},
+			Era:        syncable.Era,
+			EventIndex: rawEvent.GetIndex(),
+		}
+
+		switch {
+		case isSlashEvent(rawEvent):
+			stash, amount, ok := getStashAndAmountFromEvent(rawEvent)
+			if !ok {
+				return nil, ErrSlashSequenceNotValid
+			}
+
+			_, isValidator := stakersByValidator[stash]
+			if isValidator || !stakersByValidator[lastValidator][stash] {
+				lastValidator = stash
+			}
+
+			seq.Kind = model.SlashKindSlash
+			seq.StashAccount = stash
+			seq.ValidatorStashAccount = lastValidator
+			seq.Amount = amount
+			slashSeqs = append(slashSeqs, seq)
+
+		case rawEvent.GetSection() == sectionOffences && rawEvent.GetMethod() == eventMethodOffence:
+			seq.Kind = model.SlashKindOffence
+			seq.Amount = types.NewQuantityFromInt64(0)
+			if data := rawEvent.GetData(); len(data) > 0 {
+				seq.OffenceKind = decodeOffenceKind(data[0].GetValue())
+			}
+			slashSeqs = append(slashSeqs, seq)
+
+		case rawEvent.GetSection() == sectionImOnline && rawEvent.GetMethod() == eventMethodSomeOffline:
+			offline, err := getOfflineAccounts(rawEvent)
+			if err != nil {
+				return nil, err
+			}
+			// Account is recorded once per event, validators are recorded before nominators,
+			// so that offline validator which also nominates other offline validator is attributed to itself
+			recorded := make(map[string]bool)
+			addOffline := func(stash, validatorStash string) {
+				if recorded[stash] {
+					return
+				}
+				recorded[stash] = true
+
+				offlineSeq := seq
+				offlineSeq.Kind = model.SlashKindOffline
+				offlineSeq.StashAccount = stash
+				offlineSeq.ValidatorStashAccount = validatorStash
+				offlineSeq.Amount = types.NewQuantityFromInt64(0)
+				slashSeqs = append(slashSeqs, offlineSeq)
+			}
+			for _, accounts := range offline {
+				addOffline(accounts[0], accounts[0])
+			}
+			for _, accounts := range offline {
+				for _, stash := range accounts[1:] {
+					addOffline(stash, accounts[0])
+				}
+			}
+		}
+	}
+
+	for _, seq := range slashSeqs {
+		if !seq.Valid() {
+			return nil, ErrSlashSequenceNotValid
+		}
+	}
+	return slashSeqs, nil
+}
+
+// isSlashEvent returns true when event slashes account
+func isSlashEvent(rawEvent *eventpb.Event) bool {
+	return rawEvent.GetSection() == sectionStaking && (rawEvent.GetMethod() == eventMethodSlash || rawEvent.GetMethod() == eventMethodSlashed)
+}
+
+// getOfflineAccounts returns stash of each offline validator followed by its nominators, read from identification tuples (stash, exposure) of SomeOffline event
+func getOfflineAccounts(rawEvent *eventpb.Event) ([][]string, error) {
+	data := rawEvent.GetData()
+	if len(data) == 0 {
+		return nil, ErrSlashSequenceNotValid
+	}
+
+	var tuples []json.RawMessage
+	if err := json.Unmarshal([]byte(data[0].GetValue()), &tuples); err != nil {
+		return nil, err
+	}
+
+	var result [][]string
+	for _, rawTuple := range tuples {
+		var tuple []json.RawMessage
+		if err := json.Unmarshal(rawTuple, &tuple); err != nil || len(tuple) == 0 {
+			return nil, ErrSlashSequenceNotValid
+		}
+
+		var stash string
+		if err := json.Unmarshal(tuple[0], &stash); err != nil || stash == "" {
+			return nil, ErrSlashSequenceNotValid
+		}
+		accounts := []string{stash}
+
+		if len(tuple) > 1 {
+			var exposure struct {
+				Others []struct {
+					Who string `json:"who"`
+				} `json:"others"`
+			}
+			if err := json.Unmarshal(tuple[1], &exposure); err != nil {
+				return nil, err
+			}
+			for _, other := range exposure.Others {
+				if other.Who != "" && other.Who != stash {
+					accounts = append(accounts, other.Who)
+				}
+			}
+		}
+		result = append(result, accounts)
+	}
+	return result, nil
+}
+
+// decodeOffenceKind returns offence kind as text (ie. "im-online:offlin") when it's hex encoded printable text
+func decodeOffenceKind(value string) string {
+	decoded, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+	if err != nil || len(decoded) == 0 {
+		return value
+	}
+	for _, r := range string(decoded) {
+		if !unicode.IsPrint(r) {
+			return value
+		}
+	}
+	return string(decoded)
+}
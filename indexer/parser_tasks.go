@@ -11,7 +11,6 @@ import (
 	"time"
 
 	"github.com/figment-networks/indexing-engine/pipeline"
-	"github.com/figment-networks/polkadothub-indexer/client"
 	"github.com/figment-networks/polkadothub-indexer/config"
 	"github.com/figment-networks/polkadothub-indexer/metric"
 	"github.com/figment-networks/polkadothub-indexer/store"
@@ -31,6 +30,10 @@ const (
 	eventMethodReward     = "Reward"
 	sectionStaking        = "staking"
 
+	callArgsKey    = "args"
+	callMethodKey  = "method"
+	callSectionKey = "section"
+
 	accountKey = "AccountId"
 	balanceKey = "Balance"
 )
@@ -43,6 +46,12 @@ var (
 	errUnexpectedEventDataFormat = errors.New("unexpected event data format")
 
 	zero big.Int
+
+	// nestingCalls are calls which contain other calls in their args
+	nestingCalls = map[string][]string{
+		"utility": {"batch", "batchAll"},
+		"proxy":   {"proxy"},
+	}
 )
 
 func NewBlockParserTask() *blockParserTask {
@@ -85,20 +94,20 @@ func (t *blockParserTask) Run(ctx context.Context, p pipeline.Payload) error {
 	return nil
 }
 
-func NewValidatorsParserTask(cfg *config.Config, accountClient client.AccountClient, rewardsDb store.Rewards, syncablesDb store.Syncables, validatorDb store.ValidatorEraSeq) *validatorsParserTask {
+func NewValidatorsParserTask(cfg *config.Config, identityResolver IdentityResolver, rewardsDb store.Rewards, syncablesDb store.Syncables, validatorDb store.ValidatorEraSeq) *validatorsParserTask {
 	return &validatorsParserTask{
-		cfg:           cfg,
-		accountClient: accountClient,
-		rewardsDb:     rewardsDb,
-		syncablesDb:   syncablesDb,
-		validatorDb:   validatorDb,
+		cfg:              cfg,
+		identityResolver: identityResolver,
+		rewardsDb:        rewardsDb,
+		syncablesDb:      syncablesDb,
+		validatorDb:      validatorDb,
 	}
 }
 
 type validatorsParserTask struct {
 	cfg *config.Config
 
-	accountClient client.AccountClient
+	identityResolver IdentityResolver
 
 	rewardsDb   store.Rewards
 	syncablesDb store.Syncables
@@ -114,6 +123,21 @@ type parsedValidator struct {
 	parsedRewards parsedRewards
 }
 
+// MarshalJSON includes parsed rewards, so that they are visible when payload is dumped
+func (v parsedValidator) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Staking     *stakingpb.Validator
+		Performance *validatorperformancepb.Validator
+		DisplayName string
+		Rewards     parsedRewards
+	}{
+		Staking:     v.Staking,
+		Performance: v.Performance,
+		DisplayName: v.DisplayName,
+		Rewards:     v.parsedRewards,
+	})
+}
+
 type parsedRewards struct {
 	Commission          string
 	Reward              string
@@ -153,20 +177,27 @@ func (t *validatorsParserTask) Run(ctx context.Context, p pipeline.Payload) erro
 
 	parsedValidatorsData := make(ParsedValidatorsData)
 
+	var stashAccounts []string
+	for _, rawValidatorStakingInfo := range rawStakingState.GetValidators() {
+		stashAccounts = append(stashAccounts, rawValidatorStakingInfo.GetStashAccount())
+	}
+
+	displayNames, identities, identityChanges, err := t.identityResolver.GetDisplayNames(payload.Syncable, stashAccounts, payload.RawEvents)
+	if err != nil {
+		return err
+	}
+	payload.Identities = identities
+	payload.IdentityChanges = identityChanges
+
 	// Get validator staking info
 	for _, rawValidatorStakingInfo := range rawStakingState.GetValidators() {
 		stashAccount := rawValidatorStakingInfo.GetStashAccount()
 
-		identity, err := t.accountClient.GetIdentity(stashAccount)
-		if err != nil {
-			return err
-		}
-
 		var parsedData parsedValidator
 		parsedData, _ = parsedValidatorsData[stashAccount]
 
 		parsedData.Staking = rawValidatorStakingInfo
-		parsedData.DisplayName = strings.TrimSpace(identity.GetIdentity().GetDisplayName())
+		parsedData.DisplayName = displayNames[stashAccount]
 
 		if c != nil {
 			parsedRewards := t.getUnclaimedRewardData(c, rawValidatorStakingInfo)
@@ -189,39 +220,34 @@ func (t *validatorsParserTask) Run(ctx context.Context, p pipeline.Payload) erro
 
 	// Get claimed validator rewards
 	for _, tx := range payload.RawTransactions {
-		if tx.GetMethod() != txMethodPayoutStakers || tx.GetSection() != sectionStaking {
-			continue
-		}
-
-		validatorStash, era, err := getStashAndEraFromPayoutArgs(tx)
+		claims, err := getPayoutStakersClaims(tx)
 		if err != nil {
 			return err
 		}
 
-		//check if already exists in db, if yes mark all claimed
-		count, err := t.rewardsDb.GetCount(validatorStash, era)
-		if err != nil {
-			return err
-		}
-
-		if count == 0 {
-			var parsedData parsedValidator
-			parsedData, _ = parsedValidatorsData[validatorStash]
-
-			// these are historical rewards whose unclaimed reward data is not in the database
-			parsedRewards, err := t.getClaimedRewardDataFromEvents(validatorStash, era, payload.RawEvents)
+		for _, claim := range getExecutedClaims(tx.GetExtrinsicIndex(), claims, payload.RawEvents) {
+			//check if already exists in db, if yes mark all claimed
+			count, err := t.rewardsDb.GetCount(claim.ValidatorStash, claim.Era)
 			if err != nil {
 				return err
 			}
-			parsedData.parsedRewards = parsedRewards
-			parsedValidatorsData[validatorStash] = parsedData
-			continue
-		}
 
-		payload.RewardsClaimed = append(payload.RewardsClaimed, RewardsClaim{
-			Era:            era,
-			ValidatorStash: validatorStash,
-		})
+			if count == 0 {
+				var parsedData parsedValidator
+				parsedData, _ = parsedValidatorsData[claim.ValidatorStash]
+
+				// these are historical rewards whose unclaimed reward data is not in the database
+				parsedRewards, err := t.getClaimedRewardDataFromEvents(claim.ValidatorStash, claim.Era, payload.RawEvents)
+				if err != nil {
+					return err
+				}
+				parsedData.parsedRewards = parsedRewards
+				parsedValidatorsData[claim.ValidatorStash] = parsedData
+				continue
+			}
+
+			payload.RewardsClaimed = append(payload.RewardsClaimed, claim)
+		}
 	}
 
 	payload.ParsedValidators = parsedValidatorsData
@@ -332,18 +358,156 @@ func (t *validatorsParserTask) getStashAndAmountFromData(event *eventpb.Event) (
 	return
 }
 
+// getPayoutStakersClaims returns claims of all payoutStakers calls in transaction, including calls nested in batch and proxy calls
+func getPayoutStakersClaims(tx *transactionpb.Annotated) ([]RewardsClaim, error) {
+	if tx.GetMethod() == txMethodPayoutStakers && tx.GetSection() == sectionStaking {
+		validatorStash, era, err := getStashAndEraFromPayoutArgs(tx)
+		if err != nil {
+			return nil, err
+		}
+		return []RewardsClaim{{Era: era, ValidatorStash: validatorStash}}, nil
+	}
+
+	if !isNestingCall(tx.GetSection(), tx.GetMethod()) {
+		return nil, nil
+	}
+
+	var args interface{}
+	if err := json.Unmarshal([]byte(tx.GetArgs()), &args); err != nil {
+		return nil, err
+	}
+	return getNestedPayoutStakersClaims(args)
+}
+
+// getExecutedClaims returns claims of extrinsic which were paid out. Claims are matched with PayoutStarted events of extrinsic the same way
+// paid rewards are attributed to them. When chain does not emit them, claims are paid out only when extrinsic did not fail and no batch was interrupted.
+func getExecutedClaims(extrinsicIndex int64, claims []RewardsClaim, events []*eventpb.Event) []RewardsClaim {
+	if len(claims) == 0 {
+		return nil
+	}
+
+	started := make(map[RewardsClaim]bool)
+	var interrupted bool
+
+	for _, event := range events {
+		if event.GetExtrinsicIndex() != extrinsicIndex {
+			continue
+		}
+
+		switch {
+		case event.GetSection() == sectionSystem && event.GetMethod() == eventMethodExtrinsicFailed:
+			return nil
+		case event.GetSection() == sectionUtility && event.GetMethod() == eventMethodBatchInterrupted:
+			interrupted = true
+		case event.GetSection() == sectionStaking && event.GetMethod() == eventMethodPayoutStarted:
+			if claim, ok := getClaimFromPayoutStartedEvent(event); ok {
+				started[claim] = true
+			}
+		}
+	}
+
+	if len(started) == 0 {
+		if interrupted {
+			return nil
+		}
+		return claims
+	}
+
+	var executed []RewardsClaim
+	for _, claim := range claims {
+		if started[claim] {
+			executed = append(executed, claim)
+		}
+	}
+	return executed
+}
+
+// getNestedPayoutStakersClaims walks decoded call args and returns claims of payoutStakers calls found in them.
+// Nested calls are expected in decoded form, ie. {"section": "staking", "method": "payoutStakers", "args": {...}}
+func getNestedPayoutStakersClaims(args interface{}) ([]RewardsClaim, error) {
+	var claims []RewardsClaim
+
+	switch value := args.(type) {
+	case []interface{}:
+		for _, item := range value {
+			itemClaims, err := getNestedPayoutStakersClaims(item)
+			if err != nil {
+				return nil, err
+			}
+			claims = append(claims, itemClaims...)
+		}
+	case map[string]interface{}:
+		section, _ := value[callSectionKey].(string)
+		method, _ := value[callMethodKey].(string)
+
+		if method == txMethodPayoutStakers && section == sectionStaking {
+			validatorStash, era, err := getStashAndEraFromCallArgs(value[callArgsKey])
+			if err != nil {
+				return nil, err
+			}
+			return []RewardsClaim{{Era: era, ValidatorStash: validatorStash}}, nil
+		}
+
+		if isNestingCall(section, method) {
+			return getNestedPayoutStakersClaims(value[callArgsKey])
+		}
+	}
+
+	return claims, nil
+}
+
+func isNestingCall(section, method string) bool {
+	for _, nestingMethod := range nestingCalls[section] {
+		if nestingMethod == method {
+			return true
+		}
+	}
+	return false
+}
+
 func getStashAndEraFromPayoutArgs(tx *transactionpb.Annotated) (validatorStash string, era int64, err error) {
-	var data []string
+	var data interface{}
 
 	err = json.Unmarshal([]byte(tx.GetArgs()), &data)
 	if err != nil {
 		return validatorStash, era, err
 	}
 
-	if len(data) < 2 {
+	return getStashAndEraFromCallArgs(data)
+}
+
+// getStashAndEraFromCallArgs reads payoutStakers args given either as list [validator_stash, era] or as named args
+func getStashAndEraFromCallArgs(args interface{}) (validatorStash string, era int64, err error) {
+	var rawStash, rawEra interface{}
+
+	switch value := args.(type) {
+	case []interface{}:
+		if len(value) < 2 {
+			return validatorStash, era, errUnexpectedTxDataFormat
+		}
+		rawStash, rawEra = value[0], value[1]
+	case map[string]interface{}:
+		rawStash, rawEra = value["validator_stash"], value["era"]
+		if rawStash == nil {
+			rawStash = value["validatorStash"]
+		}
+	default:
 		return validatorStash, era, errUnexpectedTxDataFormat
 	}
-	validatorStash = data[0]
-	era, err = strconv.ParseInt(data[1], 10, 64)
+
+	validatorStash, ok := rawStash.(string)
+	if !ok || validatorStash == "" {
+		return validatorStash, era, errUnexpectedTxDataFormat
+	}
+
+	switch value := rawEra.(type) {
+	case string:
+		// human readable numbers may contain thousands separators
+		era, err = strconv.ParseInt(strings.ReplaceAll(value, ",", ""), 10, 64)
+	case float64:
+		era = int64(value)
+	default:
+		err = errUnexpectedTxDataFormat
+	}
 	return
 }
@@ -2,16 +2,16 @@ package indexer
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
 	"testing"
 
-	mock_client "github.com/figment-networks/polkadothub-indexer/mock/client"
+	mockIndexer "github.com/figment-networks/polkadothub-indexer/mock/indexer"
 	mock "github.com/figment-networks/polkadothub-indexer/mock/store"
 	"github.com/figment-networks/polkadothub-indexer/model"
 	"github.com/figment-networks/polkadothub-indexer/types"
-	"github.com/figment-networks/polkadothub-proxy/grpc/account/accountpb"
 	"github.com/figment-networks/polkadothub-proxy/grpc/block/blockpb"
 	"github.com/figment-networks/polkadothub-proxy/grpc/event/eventpb"
 	"github.com/figment-networks/polkadothub-proxy/grpc/staking/stakingpb"
@@ -137,12 +137,10 @@ func TestValidatorParserTask_Run(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			ctx := context.Background()
 
-			mockClient := mock_client.NewMockAccountClient(ctrl)
-			for _, validator := range tt.rawStakingState.GetValidators() {
-				mockClient.EXPECT().GetIdentity(validator.StashAccount).Return(&accountpb.GetIdentityResponse{Identity: &accountpb.AccountIdentity{DisplayName: ""}}, nil)
-			}
+			identityResolverMock := mockIndexer.NewMockIdentityResolver(ctrl)
+			identityResolverMock.EXPECT().GetDisplayNames(gomock.Any(), gomock.Any(), gomock.Any()).Return(map[string]string{}, nil, nil, nil).Times(1)
 
-			task := NewValidatorsParserTask(nil, mockClient, nil, nil, nil)
+			task := NewValidatorsParserTask(nil, identityResolverMock, nil, nil, nil)
 			pl := &payload{
 				RawStaking:              tt.rawStakingState,
 				RawValidatorPerformance: tt.rawValidatorPerformances,
@@ -299,10 +297,10 @@ func TestValidatorParserTask_Run(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			ctx := context.Background()
 
-			mockClient := mock_client.NewMockAccountClient(ctrl)
-			mockClient.EXPECT().GetIdentity(gomock.Any()).Return(nil, nil)
+			identityResolverMock := mockIndexer.NewMockIdentityResolver(ctrl)
+			identityResolverMock.EXPECT().GetDisplayNames(gomock.Any(), gomock.Any(), gomock.Any()).Return(map[string]string{}, nil, nil, nil).Times(1)
 
-			task := NewValidatorsParserTask(nil, mockClient, nil, nil, nil)
+			task := NewValidatorsParserTask(nil, identityResolverMock, nil, nil, nil)
 
 			pl := &payload{
 				Syncable: &model.Syncable{Era: syncableEra},
@@ -362,6 +360,7 @@ func TestValidatorParserTask_Run(t *testing.T) {
 	markClaimedTest := []struct {
 		description   string
 		txs           []*transactionpb.Annotated
+		events        []*eventpb.Event
 		expectErr     error
 		expectClaimed []RewardsClaim
 	}{
@@ -379,6 +378,30 @@ func TestValidatorParserTask_Run(t *testing.T) {
 			description: "does not update payload if there's no payout stakers transaction",
 			txs:         []*transactionpb.Annotated{{Section: "staking", Method: "Foo"}},
 		},
+		{
+			description:   "updates payload if there's payout stakers call nested in batch",
+			txs:           []*transactionpb.Annotated{testBatchTx("batch", testPayoutStakersCall(name1, "182"), testPayoutStakersCall(name2, "182"))},
+			expectClaimed: []RewardsClaim{{182, name1}, {182, name2}},
+		},
+		{
+			description:   "updates payload if there's payout stakers call nested in batch within proxy",
+			txs:           []*transactionpb.Annotated{testProxyTx(testCall("utility", "batchAll", []interface{}{[]interface{}{testPayoutStakersCall(name1, "1,020")}}))},
+			expectClaimed: []RewardsClaim{{1020, name1}},
+		},
+		{
+			description: "does not update payload if payout stakers extrinsic failed",
+			txs:         []*transactionpb.Annotated{testProxyTx(testPayoutStakersCall(name1, "182"))},
+			events:      []*eventpb.Event{{Section: "system", Method: "ExtrinsicFailed"}},
+		},
+		{
+			description: "updates payload only with payout stakers calls executed before batch was interrupted",
+			txs:         []*transactionpb.Annotated{testBatchTx("batch", testPayoutStakersCall(name1, "182"), testPayoutStakersCall(name2, "182"))},
+			events: []*eventpb.Event{
+				testPayoutStartedEventAt(0, name1, 182),
+				{Section: "utility", Method: "BatchInterrupted"},
+			},
+			expectClaimed: []RewardsClaim{{182, name1}},
+		},
 	}
 
 	for _, tt := range markClaimedTest {
@@ -392,10 +415,14 @@ func TestValidatorParserTask_Run(t *testing.T) {
 			rewardsMock := mock.NewMockRewards(ctrl)
 			rewardsMock.EXPECT().GetCount(gomock.Any(), gomock.Any()).Return(int64(1), nil).AnyTimes()
 
-			task := NewValidatorsParserTask(nil, nil, rewardsMock, nil, nil)
+			identityResolverMock := mockIndexer.NewMockIdentityResolver(ctrl)
+			identityResolverMock.EXPECT().GetDisplayNames(gomock.Any(), gomock.Any(), gomock.Any()).Return(map[string]string{}, nil, nil, nil).AnyTimes()
+
+			task := NewValidatorsParserTask(nil, identityResolverMock, rewardsMock, nil, nil)
 
 			pl := &payload{
 				RawTransactions: tt.txs,
+				RawEvents:       tt.events,
 			}
 
 			if err := task.Run(ctx, pl); err != tt.expectErr {
@@ -587,3 +614,170 @@ func testPayoutStakersTx(stash string, era int64) *transactionpb.Annotated {
 		Args:    fmt.Sprintf("[\"%v\",\"%v\"]", stash, era),
 	}
 }
+
+func testPayoutStakersCall(stash string, era string) map[string]interface{} {
+	return testCall(sectionStaking, txMethodPayoutStakers, map[string]interface{}{"validator_stash": stash, "era": era})
+}
+
+func testCall(section, method string, args interface{}) map[string]interface{} {
+	return map[string]interface{}{"section": section, "method": method, "args": args}
+}
+
+func testBatchTx(method string, calls ...map[string]interface{}) *transactionpb.Annotated {
+	args, _ := json.Marshal([]interface{}{calls})
+	return &transactionpb.Annotated{Method: method, Section: "utility", Args: string(args)}
+}
+
+func testProxyTx(call map[string]interface{}) *transactionpb.Annotated {
+	args, _ := json.Marshal([]interface{}{"real", nil, call})
+	return &transactionpb.Annotated{Method: "proxy", Section: "proxy", Args: string(args)}
+}
+
+func Test_getPayoutStakersClaims(t *testing.T) {
+	tests := []struct {
+		description string
+		tx          *transactionpb.Annotated
+		expect      []RewardsClaim
+		expectErr   error
+	}{
+		{
+			description: "returns claim of payout stakers transaction",
+			tx:          testPayoutStakersTx("stash1", 182),
+			expect:      []RewardsClaim{{182, "stash1"}},
+		},
+		{
+			description: "returns claims of payout stakers calls in batch",
+			tx:          testBatchTx("batchAll", testPayoutStakersCall("stash1", "182"), testCall("balances", "transfer", []interface{}{"stash2", "100"}), testPayoutStakersCall("stash2", "181")),
+			expect:      []RewardsClaim{{182, "stash1"}, {181, "stash2"}},
+		},
+		{
+			description: "returns claims of payout stakers calls in nested batches",
+			tx:          testBatchTx("batch", testCall("utility", "batch", []interface{}{[]interface{}{testPayoutStakersCall("stash1", "182")}})),
+			expect:      []RewardsClaim{{182, "stash1"}},
+		},
+		{
+			description: "returns claim of payout stakers call with list args in proxy",
+			tx:          testProxyTx(testCall(sectionStaking, txMethodPayoutStakers, []interface{}{"stash1", 182})),
+			expect:      []RewardsClaim{{182, "stash1"}},
+		},
+		{
+			description: "ignores payout stakers calls in args of other calls",
+			tx:          &transactionpb.Annotated{Method: "asMulti", Section: "multisig", Args: "not json"},
+		},
+		{
+			description: "returns error if payout stakers args are unexpected",
+			tx:          testBatchTx("batch", testCall(sectionStaking, txMethodPayoutStakers, []interface{}{"stash1"})),
+			expectErr:   errUnexpectedTxDataFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := getPayoutStakersClaims(tt.tx)
+			if err != tt.expectErr {
+				t.Errorf("unexpected error, want: %v; got: %v", tt.expectErr, err)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("unexpected claims, want: %v; got: %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func Test_getExecutedClaims(t *testing.T) {
+	claims := []RewardsClaim{{182, "validator1"}, {182, "validator2"}}
+
+	tests := []struct {
+		description string
+		events      []*eventpb.Event
+		expect      []RewardsClaim
+	}{
+		{
+			description: "returns all claims of successful extrinsic without payout started events",
+			events:      []*eventpb.Event{testRewardEventAt(1, "nominator1", "1")},
+			expect:      claims,
+		},
+		{
+			description: "returns claims with payout started events",
+			events: []*eventpb.Event{
+				testPayoutStartedEventAt(1, "validator2", 182),
+				testPayoutStartedEventAt(2, "validator1", 182),
+			},
+			expect: []RewardsClaim{{182, "validator2"}},
+		},
+		{
+			description: "returns claims started before batch was interrupted",
+			events: []*eventpb.Event{
+				testPayoutStartedEventAt(1, "validator1", 182),
+				{ExtrinsicIndex: 1, Section: "utility", Method: "BatchInterrupted"},
+			},
+			expect: []RewardsClaim{{182, "validator1"}},
+		},
+		{
+			description: "returns no claims of interrupted batch without payout started events",
+			events:      []*eventpb.Event{{ExtrinsicIndex: 1, Section: "utility", Method: "BatchInterrupted"}},
+		},
+		{
+			description: "returns no claims of failed extrinsic",
+			events: []*eventpb.Event{
+				testPayoutStartedEventAt(1, "validator1", 182),
+				{ExtrinsicIndex: 1, Section: "system", Method: "ExtrinsicFailed"},
+			},
+		},
+		{
+			description: "ignores failures of other extrinsics",
+			events:      []*eventpb.Event{{ExtrinsicIndex: 2, Section: "system", Method: "ExtrinsicFailed"}},
+			expect:      claims,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			got := getExecutedClaims(1, claims, tt.events)
+			if !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("unexpected claims, want: %v; got: %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestParsedValidator_MarshalJSON(t *testing.T) {
+	validator := parsedValidator{
+		DisplayName: "test",
+		parsedRewards: parsedRewards{
+			Reward:        "100",
+			StakerRewards: []stakerReward{{Stash: "stash", Amount: "10"}},
+			Era:           3,
+		},
+	}
+
+	data, err := json.Marshal(validator)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	var result struct {
+		DisplayName string
+		Rewards     parsedRewards
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	if result.DisplayName != validator.DisplayName {
+		t.Errorf("unexpected display name, want: %s; got: %s", validator.DisplayName, result.DisplayName)
+	}
+	if !reflect.DeepEqual(result.Rewards, validator.parsedRewards) {
+		t.Errorf("unexpected rewards, want: %+v; got: %+v", validator.parsedRewards, result.Rewards)
+	}
+}
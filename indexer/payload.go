@@ -29,8 +29,8 @@ func (pf *payloadFactory) GetPayload(currentHeight int64) pipeline.Payload {
 }
 
 type RewardsClaim struct {
-	Era            int64
-	ValidatorStash string
+	Era            int64  `json:"era"`
+	ValidatorStash string `json:"validator_stash"`
 }
 
 type payload struct {
@@ -51,6 +51,8 @@ type payload struct {
 	// Parser stage
 	ParsedBlock      ParsedBlockData
 	ParsedValidators ParsedValidatorsData
+	Identities       []model.Identity
+	IdentityChanges  []model.IdentityChange
 
 	// Aggregator stage
 	NewValidatorAggregates     []model.ValidatorAgg
@@ -67,9 +69,12 @@ type payload struct {
 	TransactionSequences      []model.TransactionSeq
 	RewardEraSequences        []model.RewardEraSeq
 	RewardsClaimed            []RewardsClaim
+	SlashSequences            []model.SlashSeq
+	AccountBalanceSequences   []model.AccountBalanceSeq
 
 	// Analyzer
-	SystemEvents []model.SystemEvent
+	SystemEvents        []model.SystemEvent
+	RewardDiscrepancies []model.RewardDiscrepancy
 }
 
 func (p *payload) MarkAsProcessed() {}
@@ -23,6 +23,10 @@ const (
 	ValidatorSeqPersistorTaskName        = "ValidatorSeqPersistor"
 	SystemEventPersistorTaskName         = "SystemEventPersistor"
 	RewardEraSeqPersistorTaskName        = "RewardEraSeqPersistor"
+	RewardDiscrepancyPersistorTaskName   = "RewardDiscrepancyPersistor"
+	SlashSeqPersistorTaskName            = "SlashSeqPersistor"
+	AccountBalanceSeqPersistorTaskName   = "AccountBalanceSeqPersistor"
+	IdentityPersistorTaskName            = "IdentityPersistor"
 )
 
 // NewSyncerPersistorTask is responsible for storing syncable to persistence layer
@@ -340,10 +344,106 @@ func (t *RewardEraSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload)
 	}
 
 	for _, claim := range payload.RewardsClaimed {
-		err = t.rewardsDb.MarkAllClaimed(claim.ValidatorStash, claim.Era)
+		err = t.rewardsDb.MarkAllClaimed(claim.ValidatorStash, claim.Era, payload.CurrentHeight)
 		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+func NewRewardDiscrepancyPersistorTask(rewardsDb store.Rewards) pipeline.Task {
+	return &RewardDiscrepancyPersistorTask{
+		rewardsDb: rewardsDb,
+	}
+}
+
+type RewardDiscrepancyPersistorTask struct {
+	rewardsDb store.Rewards
+}
+
+func (t *RewardDiscrepancyPersistorTask) GetName() string {
+	return RewardDiscrepancyPersistorTaskName
+}
+
+func (t *RewardDiscrepancyPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	defer metric.LogIndexerTaskDuration(time.Now(), t.GetName())
+
+	payload := p.(*payload)
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	return t.rewardsDb.BulkUpsertDiscrepancies(payload.RewardDiscrepancies)
+}
+
+func NewSlashSeqPersistorTask(slashDb store.Slashes) pipeline.Task {
+	return &slashSeqPersistorTask{
+		slashDb: slashDb,
+	}
+}
+
+type slashSeqPersistorTask struct {
+	slashDb store.Slashes
+}
+
+func (t *slashSeqPersistorTask) GetName() string {
+	return SlashSeqPersistorTaskName
+}
+
+func (t *slashSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	defer metric.LogIndexerTaskDuration(time.Now(), t.GetName())
+
+	payload := p.(*payload)
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	return t.slashDb.BulkUpsert(payload.SlashSequences)
+}
+
+func NewAccountBalanceSeqPersistorTask(accountBalanceSeqDb store.AccountBalanceSeq) pipeline.Task {
+	return &accountBalanceSeqPersistorTask{
+		accountBalanceSeqDb: accountBalanceSeqDb,
+	}
+}
+
+type accountBalanceSeqPersistorTask struct {
+	accountBalanceSeqDb store.AccountBalanceSeq
+}
+
+func (t *accountBalanceSeqPersistorTask) GetName() string {
+	return AccountBalanceSeqPersistorTaskName
+}
+
+func (t *accountBalanceSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	defer metric.LogIndexerTaskDuration(time.Now(), t.GetName())
+
+	payload := p.(*payload)
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	return t.accountBalanceSeqDb.BulkUpsertBalanceSeqs(payload.AccountBalanceSequences)
+}
+
+// NewIdentityPersistorTask is responsible for storing identities refreshed by parser and changes of their display names
+func NewIdentityPersistorTask(identityDb store.Identities) pipeline.Task {
+	return &identityPersistorTask{
+		identityDb: identityDb,
+	}
+}
+
+type identityPersistorTask struct {
+	identityDb store.Identities
+}
+
+func (t *identityPersistorTask) GetName() string {
+	return IdentityPersistorTaskName
+}
+
+func (t *identityPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	defer metric.LogIndexerTaskDuration(time.Now(), t.GetName())
+
+	payload := p.(*payload)
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	if err := t.identityDb.BulkUpsertChanges(payload.IdentityChanges); err != nil {
+		return err
+	}
+	return t.identityDb.BulkUpsert(payload.Identities)
+}
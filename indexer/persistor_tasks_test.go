@@ -369,6 +369,45 @@ func TestAccountEraSequencePersistor_Run(t *testing.T) {
 	}
 }
 
+func TestAccountBalanceSequencePersistor_Run(t *testing.T) {
+	seqs := []model.AccountBalanceSeq{
+		{Sequence: &model.Sequence{Height: 10}, StashAccount: "acount1"},
+		{Sequence: &model.Sequence{Height: 10}, StashAccount: "acount2"},
+	}
+
+	tests := []struct {
+		description string
+		expectErr   error
+	}{
+		{"calls db with all account balance seqs", nil},
+		{"returns error if database errors", fmt.Errorf("db err")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockAccountBalanceSeq(ctrl)
+
+			task := NewAccountBalanceSeqPersistorTask(dbMock)
+
+			pl := &payload{
+				Syncable:                &model.Syncable{},
+				AccountBalanceSequences: seqs,
+			}
+
+			dbMock.EXPECT().BulkUpsertBalanceSeqs(pl.AccountBalanceSequences).Return(tt.expectErr).Times(1)
+
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("want %v; got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
 func TestValidatorSeqPersistor_Run(t *testing.T) {
 	seqTime := *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC))
 
@@ -444,6 +483,7 @@ func TestRewardPersistor_Run(t *testing.T) {
 			t.Parallel()
 
 			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
 			ctx := context.Background()
 
 			dbMock := mock.NewMockRewards(ctrl)
@@ -451,14 +491,62 @@ func TestRewardPersistor_Run(t *testing.T) {
 			task := NewRewardEraSeqPersistorTask(dbMock)
 
 			pl := &payload{
+				CurrentHeight:      20,
 				RewardEraSequences: tt.rewards,
+				RewardsClaimed:     tt.claims,
 			}
 
 			dbMock.EXPECT().BulkUpsert(tt.rewards).Return(tt.upsertErr).Times(1)
 
 			for _, claim := range tt.claims {
-				dbMock.EXPECT().MarkAllClaimed(claim.ValidatorStash, claim.Era).Return(nil).Times(1)
+				dbMock.EXPECT().MarkAllClaimed(claim.ValidatorStash, claim.Era, pl.CurrentHeight).Return(nil).Times(1)
+			}
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("want %v; got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestIdentityPersistor_Run(t *testing.T) {
+	identities := []model.Identity{{StashAccount: "acct1", DisplayName: "name1"}}
+	changes := []model.IdentityChange{{Height: 10, StashAccount: "acct1", DisplayName: "name1", PreviousDisplayName: "old"}}
+	dbErr := fmt.Errorf("db err")
+
+	tests := []struct {
+		description    string
+		changesErr     error
+		identitiesErr  error
+		expectErr      error
+		expectUpserted bool
+	}{
+		{"calls db with identity changes and identities", nil, nil, nil, true},
+		{"returns error if changes cannot be persisted", dbErr, nil, dbErr, false},
+		{"returns error if identities cannot be persisted", nil, dbErr, dbErr, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockIdentities(ctrl)
+
+			task := NewIdentityPersistorTask(dbMock)
+
+			pl := &payload{
+				Syncable:        &model.Syncable{},
+				Identities:      identities,
+				IdentityChanges: changes,
 			}
+
+			dbMock.EXPECT().BulkUpsertChanges(changes).Return(tt.changesErr).Times(1)
+			if tt.expectUpserted {
+				dbMock.EXPECT().BulkUpsert(identities).Return(tt.identitiesErr).Times(1)
+			}
+
 			if err := task.Run(ctx, pl); err != tt.expectErr {
 				t.Errorf("want %v; got %v", tt.expectErr, err)
 			}
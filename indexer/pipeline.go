@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/figment-networks/indexing-engine/pipeline"
 	"github.com/figment-networks/polkadothub-indexer/client"
@@ -16,7 +17,6 @@ import (
 
 const (
 	CtxReport     = "context_report"
-	maxRetries    = 3
 	StageAnalyzer = "AnalyzerStage"
 )
 
@@ -24,26 +24,46 @@ var (
 	ErrIsPristine          = errors.New("cannot run because database is empty")
 	ErrIndexCannotBeRun    = errors.New("cannot run index process")
 	ErrBackfillCannotBeRun = errors.New("cannot run backfill process")
+	ErrNothingToBackfill   = errors.New("nothing to backfill, all targets are up to date")
 )
 
 type indexingPipeline struct {
 	cfg    *config.Config
 	client *client.Client
 
-	status       *pipelineStatus
-	configParser ConfigParser
-	pipeline     pipeline.CustomPipeline
+	// statusChecker seeds target ranges, so status is checked only by processes which index heights
+	statusChecker *pipelineStatusChecker
+	configParser  ConfigParser
+	pipeline      pipeline.CustomPipeline
+	reorgHandler  *reorgHandler
+	prefetcher    *prefetchingClient
 
-	databaseDb store.Database
-	reportDb   store.Reports
-	syncableDb store.Syncables
+	failedHeightRecorder *failedHeightRecorder
+
+	// payloadSinks receive payloads of processed heights before they are marked as processed
+	payloadSinks []PayloadSink
+
+	reportCheckpointInterval time.Duration
+
+	databaseDb     store.Database
+	failedHeightDb store.FailedHeights
+	reportDb       store.Reports
+	syncableDb     store.Syncables
+	targetRangeDb  store.TargetRanges
 }
 
-func NewPipeline(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, reportDb store.Reports,
-	rewardDb store.Rewards, syncableDb store.Syncables, systemEventDb store.SystemEvents, transactionDb store.Transactions, validatorDb store.Validators,
+func NewPipeline(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
 ) (*indexingPipeline, error) {
 	p := pipeline.NewCustom(NewPayloadFactory())
 
+	prefetcher := NewPrefetchingClient(cli.Height, cfg.FetcherPrefetchWindow)
+
+	identityCacheTTL, err := time.ParseDuration(cfg.IdentityCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+
 	// Setup logger
 	p.SetLogger(NewLogger())
 
@@ -51,36 +71,50 @@ func NewPipeline(cfg *config.Config, cli *client.Client, accountDb store.Account
 	p.AddStage(
 		pipeline.NewStageWithTasks(
 			pipeline.StageFetcher,
-			pipeline.RetryingTask(NewFetcherTask(cli.Height), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorFetcherTask(cli.Validator), isTransient, maxRetries),
+			withFailureTracking(pipeline.StageFetcher,
+				RetryingTask(NewFetcherTask(prefetcher)),
+				RetryingTask(NewValidatorFetcherTask(cli.Validator)),
+			)...,
 		),
 	)
 
 	// Syncer stage
 	p.AddStage(
-		pipeline.NewStageWithTasks(pipeline.StageSyncer, pipeline.RetryingTask(NewMainSyncerTask(syncableDb), isTransient, maxRetries)),
+		pipeline.NewStageWithTasks(
+			pipeline.StageSyncer,
+			withFailureTracking(pipeline.StageSyncer,
+				RetryingTask(NewMainSyncerTask(syncableDb)),
+			)...,
+		),
 	)
 
 	// Set parser stage
 	p.AddStage(
 		pipeline.NewAsyncStageWithTasks(
 			pipeline.StageParser,
-			NewBlockParserTask(),
-			pipeline.RetryingTask(NewValidatorsParserTask(cfg, cli.Account, rewardDb, syncableDb, validatorDb), isTransient, 1),
+			withFailureTracking(pipeline.StageParser,
+				NewBlockParserTask(),
+				NewValidatorsParserTask(cfg, NewIdentityResolver(cli.Account, identityDb, identityCacheTTL), rewardDb, syncableDb, validatorDb),
+			)...,
 		),
 	)
 
 	p.AddStage(
 		pipeline.NewAsyncStageWithTasks(
 			pipeline.StageSequencer,
-			pipeline.RetryingTask(NewBlockSeqCreatorTask(blockDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorSeqCreatorTask(validatorDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorSessionSeqCreatorTask(cfg, syncableDb, validatorDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorEraSeqCreatorTask(cfg, syncableDb, validatorDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewEventSeqCreatorTask(eventDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewAccountEraSeqCreatorTask(cfg, accountDb, syncableDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewTransactionSeqCreatorTask(transactionDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewRewardEraSeqCreatorTask(cfg, syncableDb), isTransient, maxRetries),
+			withFailureTracking(pipeline.StageSequencer,
+				RetryingTask(NewBlockSeqCreatorTask(blockDb)),
+				RetryingTask(NewValidatorSeqCreatorTask(validatorDb)),
+				RetryingTask(NewValidatorSessionSeqCreatorTask(cfg, syncableDb, validatorDb)),
+				RetryingTask(NewValidatorEraSeqCreatorTask(cfg, syncableDb, validatorDb)),
+				RetryingTask(NewEventSeqCreatorTask(eventDb)),
+				RetryingTask(NewAccountEraSeqCreatorTask(cfg, accountDb, syncableDb)),
+				RetryingTask(NewTransactionSeqCreatorTask(transactionDb)),
+				RetryingTask(NewRewardEraSeqCreatorTask(cfg, syncableDb)),
+				RetryingTask(NewSlashSeqCreatorTask(cfg, cli.Staking, syncableDb)),
+				// Balance of each account is retried by task itself, so that single failing account doesn't refetch all of them
+				NewAccountBalanceSeqCreatorTask(cfg, cli.Account),
+			)...,
 		),
 	)
 
@@ -88,7 +122,9 @@ func NewPipeline(cfg *config.Config, cli *client.Client, accountDb store.Account
 	p.AddStage(
 		pipeline.NewStageWithTasks(
 			pipeline.StageAggregator,
-			pipeline.RetryingTask(NewValidatorAggCreatorTask(validatorDb), isTransient, maxRetries),
+			withFailureTracking(pipeline.StageAggregator,
+				RetryingTask(NewValidatorAggCreatorTask(validatorDb)),
+			)...,
 		),
 	)
 
@@ -96,9 +132,14 @@ func NewPipeline(cfg *config.Config, cli *client.Client, accountDb store.Account
 	p.AddStage(
 		pipeline.NewStageWithTasks(
 			StageAnalyzer,
-			pipeline.RetryingTask(NewEraSystemEventCreatorTask(cfg, accountDb, validatorDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewSessionSystemEventCreatorTask(cfg, syncableDb, systemEventDb, validatorDb, validatorDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewSystemEventCreatorTask(cfg, validatorDb), isTransient, maxRetries),
+			withFailureTracking(StageAnalyzer,
+				RetryingTask(NewEraSystemEventCreatorTask(cfg, accountDb, validatorDb)),
+				RetryingTask(NewIdentitySystemEventCreatorTask(identityDb)),
+				RetryingTask(NewRewardReconcilerTask(rewardDb)),
+				RetryingTask(NewSlashSystemEventCreatorTask()),
+				RetryingTask(NewSessionSystemEventCreatorTask(cfg, syncableDb, systemEventDb, validatorDb, validatorDb)),
+				RetryingTask(NewSystemEventCreatorTask(cfg, validatorDb)),
+			)...,
 		),
 	)
 
@@ -106,52 +147,122 @@ func NewPipeline(cfg *config.Config, cli *client.Client, accountDb store.Account
 	p.AddStage(
 		pipeline.NewAsyncStageWithTasks(
 			pipeline.StagePersistor,
-			pipeline.RetryingTask(NewSyncerPersistorTask(syncableDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewBlockSeqPersistorTask(blockDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorSeqPersistorTask(validatorDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorSessionSeqPersistorTask(validatorDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorEraSeqPersistorTask(validatorDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorAggPersistorTask(validatorDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewEventSeqPersistorTask(eventDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewAccountEraSeqPersistorTask(accountDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewTransactionSeqPersistorTask(transactionDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewSystemEventPersistorTask(systemEventDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewRewardEraSeqPersistorTask(rewardDb), isTransient, maxRetries),
+			withFailureTracking(pipeline.StagePersistor,
+				RetryingTask(NewSyncerPersistorTask(syncableDb)),
+				RetryingTask(NewBlockSeqPersistorTask(blockDb)),
+				RetryingTask(NewValidatorSeqPersistorTask(validatorDb)),
+				RetryingTask(NewValidatorSessionSeqPersistorTask(validatorDb)),
+				RetryingTask(NewValidatorEraSeqPersistorTask(validatorDb)),
+				RetryingTask(NewValidatorAggPersistorTask(validatorDb)),
+				RetryingTask(NewEventSeqPersistorTask(eventDb)),
+				RetryingTask(NewAccountEraSeqPersistorTask(accountDb)),
+				RetryingTask(NewTransactionSeqPersistorTask(transactionDb)),
+				RetryingTask(NewSystemEventPersistorTask(systemEventDb)),
+				RetryingTask(NewRewardEraSeqPersistorTask(rewardDb)),
+				RetryingTask(NewRewardDiscrepancyPersistorTask(rewardDb)),
+				RetryingTask(NewSlashSeqPersistorTask(slashDb)),
+				RetryingTask(NewAccountBalanceSeqPersistorTask(accountDb)),
+				RetryingTask(NewIdentityPersistorTask(identityDb)),
+			)...,
 		),
 	)
 
-	// Create config parser
-	configParser, err := NewConfigParser(cfg.IndexerConfigFile)
+	reportCheckpointInterval, err := time.ParseDuration(cfg.ReportCheckpointInterval)
 	if err != nil {
 		return nil, err
 	}
 
-	statusChecker := pipelineStatusChecker{syncableDb, configParser.GetCurrentVersionId()}
-	pipelineStatus, err := statusChecker.getStatus()
+	payloadSinks, err := newPayloadSinks(cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	// Create config parser
+	configParser, err := NewConfigParser(cfg.IndexerConfigFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if problems := configParser.Validate(); len(problems) > 0 {
+		return nil, newConfigValidationError(cfg.IndexerConfigFile, problems)
+	}
+
+	statusChecker := &pipelineStatusChecker{
+		syncablesDb:         syncableDb,
+		currentIndexVersion: configParser.GetCurrentVersionId(),
+		coverageChecker: &targetCoverageChecker{
+			configParser:  configParser,
+			targetRangeDb: targetRangeDb,
+		},
+	}
+	reorgHandler := &reorgHandler{
+		cfg:          cfg,
+		client:       cli.Block,
+		indexVersion: configParser.GetCurrentVersionId(),
+
+		databaseDb:    databaseDb,
+		reportDb:      reportDb,
+		syncableDb:    syncableDb,
+		systemEventDb: systemEventDb,
+	}
+
 	return &indexingPipeline{
 		cfg:    cfg,
 		client: cli,
 
-		pipeline:     p,
-		status:       pipelineStatus,
-		configParser: configParser,
+		pipeline:      p,
+		statusChecker: statusChecker,
+		configParser:  configParser,
+		reorgHandler:  reorgHandler,
+		prefetcher:    prefetcher,
+
+		failedHeightRecorder: &failedHeightRecorder{
+			indexVersion:   configParser.GetCurrentVersionId(),
+			failedHeightDb: failedHeightDb,
+		},
+
+		payloadSinks: payloadSinks,
+
+		reportCheckpointInterval: reportCheckpointInterval,
 
-		databaseDb: databaseDb,
-		reportDb:   reportDb,
-		syncableDb: syncableDb,
+		databaseDb:     databaseDb,
+		failedHeightDb: failedHeightDb,
+		reportDb:       reportDb,
+		syncableDb:     syncableDb,
+		targetRangeDb:  targetRangeDb,
 	}, nil
 }
 
+// withPayloadSinks returns sink which passes payload to payload sinks before given sink marks height as processed
+func (p *indexingPipeline) withPayloadSinks(sink *sink) pipeline.Sink {
+	if len(p.payloadSinks) == 0 {
+		return sink
+	}
+
+	sinks := make([]pipeline.Sink, 0, len(p.payloadSinks)+1)
+	for _, payloadSink := range p.payloadSinks {
+		sinks = append(sinks, payloadSink)
+	}
+	return NewMultiSink(append(sinks, sink)...)
+}
+
+// closePayloadSinks closes payload sinks after pipeline run
+func (p *indexingPipeline) closePayloadSinks() {
+	for _, payloadSink := range p.payloadSinks {
+		if err := payloadSink.Close(); err != nil {
+			logger.Error(err)
+		}
+	}
+}
+
 type IndexConfig struct {
 	BatchSize   int64
 	StartHeight int64
+	SkipFailed  bool
 }
 
-// Start starts indexing process
+// Start starts indexing process. Heights which failed with non-transient errors are recorded and, when SkipFailed is set,
+// indexing continues after them within the same report. After chain reorganization indexing continues from common ancestor.
 func (p *indexingPipeline) Start(ctx context.Context, indexCfg IndexConfig) error {
 	if err := p.canRunIndex(); err != nil {
 		return err
@@ -159,28 +270,159 @@ func (p *indexingPipeline) Start(ctx context.Context, indexCfg IndexConfig) erro
 
 	indexVersion := p.configParser.GetCurrentVersionId()
 
-	source, err := NewIndexSource(p.cfg, p.syncableDb, p.client, &IndexSourceConfig{
-		BatchSize:   indexCfg.BatchSize,
-		StartHeight: indexCfg.StartHeight,
-	})
+	versionIds := p.configParser.GetAllVersionedVersionIds()
+	pipelineOptionsCreator := &pipelineOptionsCreator{
+		configParser:      p.configParser,
+		desiredVersionIds: versionIds,
+	}
+	pipelineOptions, err := pipelineOptionsCreator.parse()
 	if err != nil {
 		return err
 	}
 
-	sink := NewSink(p.databaseDb, p.syncableDb, indexVersion)
+	source, err := p.newIndexSource(indexCfg)
+	if err != nil {
+		return err
+	}
 
 	reportCreator := &reportCreator{
 		kind:         model.ReportKindIndex,
 		indexVersion: indexVersion,
 		startHeight:  source.startHeight,
 		endHeight:    source.endHeight,
-		reportDb:     p.reportDb,
+
+		checkpointInterval: p.reportCheckpointInterval,
+		reportDb:           p.reportDb,
 	}
 
 	if err := reportCreator.create(); err != nil {
 		return err
 	}
 
+	defer p.closePayloadSinks()
+
+	var totalCount, successCount int64
+	for {
+		p.prefetcher.setMaxHeight(source.endHeight)
+
+		reportCreator.report.EndHeight = source.endHeight
+
+		sink := NewSink(p.databaseDb, p.syncableDb, p.targetRangeDb, indexVersion, p.configParser.GetAllVersionedTargetIds())
+		sink.reportCreator = reportCreator
+		sink.successCount = successCount
+
+		logger.Info(fmt.Sprintf("starting pipeline [start=%d] [end=%d]", source.startHeight, source.endHeight))
+
+		ctxWithReport := context.WithValue(ctx, CtxReport, reportCreator.report)
+		err = p.pipeline.Start(ctxWithReport, source, p.withPayloadSinks(sink), pipelineOptions)
+		if err != nil {
+			metric.IndexerTotalErrors.Inc()
+		}
+
+		logger.Info(fmt.Sprintf("pipeline completed [Err: %+v]", err))
+
+		successCount = sink.successCount
+		if err == nil {
+			totalCount += source.Len()
+			return reportCreator.complete(totalCount, successCount, nil)
+		}
+		totalCount += source.Current() - source.startHeight + 1
+
+		var reorgErr *ReorgError
+		if errors.As(err, &reorgErr) {
+			ancestor, err := p.reorgHandler.handle(reorgErr)
+			if err != nil {
+				return p.completeWithError(reportCreator, totalCount, successCount, err)
+			}
+
+			// Re-index heights from common ancestor of indexed and canonical chain. Heights above it were rolled back,
+			// so run continues right after it even when caller started at later height.
+			indexCfg.StartHeight = ancestor.Height + 1
+		} else {
+			nextHeight, err := p.handleFailedHeight(ctx, source.Current(), err, indexCfg.SkipFailed)
+			if err != nil {
+				return p.completeWithError(reportCreator, totalCount, successCount, err)
+			}
+			indexCfg.StartHeight = nextHeight
+		}
+
+		source, err = p.newIndexSource(indexCfg)
+		if err == ErrNothingToProcess {
+			// Skipped height was the last one
+			return reportCreator.complete(totalCount, successCount, nil)
+		}
+		if err != nil {
+			return p.completeWithError(reportCreator, totalCount, successCount, err)
+		}
+	}
+}
+
+func (p *indexingPipeline) newIndexSource(indexCfg IndexConfig) (*indexSource, error) {
+	return NewIndexSource(p.cfg, p.syncableDb, p.client, &IndexSourceConfig{
+		BatchSize:   indexCfg.BatchSize,
+		StartHeight: indexCfg.StartHeight,
+	})
+}
+
+// handleFailedHeight records height which failed with non-transient error and returns height following it when failed
+// heights are skipped. Transient errors and errors caused by shutdown are returned, so that height is indexed again by next run.
+func (p *indexingPipeline) handleFailedHeight(ctx context.Context, height int64, pipelineErr error, skipFailed bool) (int64, error) {
+	// Heights interrupted by shutdown did not fail, so they are not recorded
+	if ctx.Err() != nil || isTransientError(pipelineErr) {
+		return 0, pipelineErr
+	}
+
+	if err := p.failedHeightRecorder.record(height, pipelineErr); err != nil {
+		return 0, err
+	}
+
+	if !skipFailed {
+		return 0, pipelineErr
+	}
+
+	logger.Info(fmt.Sprintf("skipping failed height [height=%d]", height))
+
+	return height + 1, nil
+}
+
+// completeWithError completes report of run which stopped with error and returns the error
+func (p *indexingPipeline) completeWithError(reportCreator *reportCreator, totalCount, successCount int64, err error) error {
+	if completeErr := reportCreator.complete(totalCount, successCount, err); completeErr != nil {
+		return completeErr
+	}
+	return err
+}
+
+func (p *indexingPipeline) canRunIndex() error {
+	status, err := p.statusChecker.getStatus()
+	if err != nil {
+		return err
+	}
+
+	if !status.isPristine && !status.isUpToDate {
+		if p.configParser.IsAnyTargetSequential(status.missingTargetIds) {
+			return ErrIndexCannotBeRun
+		}
+	}
+	return nil
+}
+
+type StreamConfig struct {
+	StartHeight  int64
+	PollInterval time.Duration
+	MaxBackoff   time.Duration
+	SkipFailed   bool
+}
+
+// Stream follows chain head and indexes new heights as soon as they appear.
+// It runs until context is done or pipeline fails. Failed heights are handled the same way as by Start.
+func (p *indexingPipeline) Stream(ctx context.Context, streamCfg StreamConfig) error {
+	if err := p.canRunIndex(); err != nil {
+		return err
+	}
+
+	indexVersion := p.configParser.GetCurrentVersionId()
+
 	versionIds := p.configParser.GetAllVersionedVersionIds()
 	pipelineOptionsCreator := &pipelineOptionsCreator{
 		configParser:      p.configParser,
@@ -191,27 +433,82 @@ func (p *indexingPipeline) Start(ctx context.Context, indexCfg IndexConfig) erro
 		return err
 	}
 
-	logger.Info(fmt.Sprintf("starting pipeline [start=%d] [end=%d]", source.startHeight, source.endHeight))
-
-	ctxWithReport := context.WithValue(ctx, CtxReport, reportCreator.report)
-	err = p.pipeline.Start(ctxWithReport, source, sink, pipelineOptions)
+	source, err := p.newStreamSource(ctx, streamCfg)
 	if err != nil {
-		metric.IndexerTotalErrors.Inc()
+		return err
 	}
 
-	logger.Info(fmt.Sprintf("pipeline completed [Err: %+v]", err))
+	reportCreator := &reportCreator{
+		kind:         model.ReportKindIndex,
+		indexVersion: indexVersion,
+		startHeight:  source.startHeight,
+		endHeight:    source.headHeight,
 
-	err = reportCreator.complete(source.Len(), sink.successCount, err)
-	return err
-}
+		checkpointInterval: p.reportCheckpointInterval,
+		reportDb:           p.reportDb,
+	}
 
-func (p *indexingPipeline) canRunIndex() error {
-	if !p.status.isPristine && !p.status.isUpToDate {
-		if p.configParser.IsAnyVersionSequential(p.status.missingVersionIds) {
-			return ErrIndexCannotBeRun
+	if err := reportCreator.create(); err != nil {
+		return err
+	}
+
+	defer p.closePayloadSinks()
+
+	var totalCount, successCount int64
+	for {
+		sink := NewSink(p.databaseDb, p.syncableDb, p.targetRangeDb, indexVersion, p.configParser.GetAllVersionedTargetIds())
+		sink.reportCreator = reportCreator
+		sink.successCount = successCount
+
+		logger.Info(fmt.Sprintf("starting pipeline stream [start=%d] [head=%d]", source.startHeight, source.headHeight))
+
+		ctxWithReport := context.WithValue(ctx, CtxReport, reportCreator.report)
+		err = p.pipeline.Start(ctxWithReport, source, p.withPayloadSinks(sink), pipelineOptions)
+		if err != nil {
+			metric.IndexerTotalErrors.Inc()
+		}
+
+		logger.Info(fmt.Sprintf("pipeline stream stopped [end=%d] [Err: %+v]", source.Current(), err))
+
+		reportCreator.report.EndHeight = source.Current()
+
+		successCount = sink.successCount
+		totalCount += source.Len()
+		if err == nil {
+			return reportCreator.complete(totalCount, successCount, nil)
+		}
+
+		var reorgErr *ReorgError
+		if errors.As(err, &reorgErr) {
+			ancestor, err := p.reorgHandler.handle(reorgErr)
+			if err != nil {
+				return p.completeWithError(reportCreator, totalCount, successCount, err)
+			}
+
+			// Continue streaming from common ancestor of indexed and canonical chain
+			streamCfg.StartHeight = ancestor.Height + 1
+		} else {
+			nextHeight, err := p.handleFailedHeight(ctx, source.Current(), err, streamCfg.SkipFailed)
+			if err != nil {
+				return p.completeWithError(reportCreator, totalCount, successCount, err)
+			}
+			streamCfg.StartHeight = nextHeight
+		}
+
+		source, err = p.newStreamSource(ctx, streamCfg)
+		if err != nil {
+			return p.completeWithError(reportCreator, totalCount, successCount, err)
 		}
 	}
-	return nil
+}
+
+func (p *indexingPipeline) newStreamSource(ctx context.Context, streamCfg StreamConfig) (*streamSource, error) {
+	return NewStreamSource(ctx, p.cfg, p.syncableDb, p.client.Chain, &StreamSourceConfig{
+		StartHeight:  streamCfg.StartHeight,
+		PollInterval: streamCfg.PollInterval,
+		MaxBackoff:   streamCfg.MaxBackoff,
+		OnNewHead:    p.prefetcher.setMaxHeight,
+	})
 }
 
 type BackfillConfig struct {
@@ -221,19 +518,27 @@ type BackfillConfig struct {
 }
 
 func (p *indexingPipeline) Backfill(ctx context.Context, backfillCfg BackfillConfig) error {
-	if err := p.canRunBackfill(backfillCfg.Parallel); err != nil {
+	status, err := p.statusChecker.getStatus()
+	if err != nil {
 		return err
 	}
 
-	indexVersion := p.configParser.GetCurrentVersionId()
-	isLastInSession := p.configParser.IsLastInSession()
-	isLastInEra := p.configParser.IsLastInEra()
-	source, err := NewBackfillSource(p.cfg, p.syncableDb, p.client, indexVersion, isLastInSession, isLastInEra)
+	if err := canRunBackfill(status); err != nil {
+		return err
+	}
+
+	targetIds, err := getBackfillTargetIds(status, backfillCfg.TargetIds)
 	if err != nil {
 		return err
 	}
 
-	sink := NewSink(p.databaseDb, p.syncableDb, indexVersion)
+	if backfillCfg.Parallel && p.configParser.IsAnyTargetSequential(targetIds) {
+		return ErrBackfillCannotBeRun
+	}
+
+	startHeight, endHeight := getMissingHeightRange(status.targetsCoverage, targetIds)
+
+	indexVersion := p.configParser.GetCurrentVersionId()
 
 	kind := model.ReportKindSequentialReindex
 	if backfillCfg.Parallel {
@@ -247,24 +552,42 @@ func (p *indexingPipeline) Backfill(ctx context.Context, backfillCfg BackfillCon
 	}
 
 	reportCreator := &reportCreator{
-		kind:         kind,
-		indexVersion: indexVersion,
-		startHeight:  source.startHeight,
-		endHeight:    source.endHeight,
-		reportDb:     p.reportDb,
+		kind:               kind,
+		indexVersion:       indexVersion,
+		startHeight:        startHeight,
+		endHeight:          endHeight,
+		checkpointInterval: p.reportCheckpointInterval,
+		reportDb:           p.reportDb,
 	}
 
-	versionIds := p.status.missingVersionIds
-	pipelineOptionsCreator := &pipelineOptionsCreator{
-		configParser:      p.configParser,
-		desiredVersionIds: versionIds,
+	if err := reportCreator.createIfNotExists(model.ReportKindSequentialReindex, model.ReportKindParallelReindex); err != nil {
+		return err
 	}
-	pipelineOptions, err := pipelineOptionsCreator.parse()
+
+	// Resume backfill from last checkpoint of interrupted report
+	if resumeHeight := reportCreator.resumeHeight(); resumeHeight > startHeight && resumeHeight <= endHeight {
+		logger.Info(fmt.Sprintf("resuming backfill from checkpoint [height=%d] [report=%d]", resumeHeight, reportCreator.report.ID))
+		startHeight = resumeHeight
+	}
+
+	isLastInSession := p.configParser.IsLastInSession()
+	isLastInEra := p.configParser.IsLastInEra()
+	source, err := NewBackfillSource(p.cfg, p.syncableDb, p.client, indexVersion, isLastInSession, isLastInEra, startHeight, endHeight)
 	if err != nil {
 		return err
 	}
 
-	if err := reportCreator.createIfNotExists(model.ReportKindSequentialReindex, model.ReportKindParallelReindex); err != nil {
+	p.prefetcher.setMaxHeight(source.endHeight)
+
+	sink := NewSink(p.databaseDb, p.syncableDb, p.targetRangeDb, indexVersion, targetIds)
+	sink.reportCreator = reportCreator
+
+	pipelineOptionsCreator := &pipelineOptionsCreator{
+		configParser:     p.configParser,
+		desiredTargetIds: targetIds,
+	}
+	pipelineOptions, err := pipelineOptionsCreator.parse()
+	if err != nil {
 		return err
 	}
 
@@ -272,11 +595,13 @@ func (p *indexingPipeline) Backfill(ctx context.Context, backfillCfg BackfillCon
 		return err
 	}
 
+	defer p.closePayloadSinks()
+
 	ctxWithReport := context.WithValue(ctx, CtxReport, reportCreator.report)
 
-	logger.Info(fmt.Sprintf("starting pipeline backfill [start=%d] [end=%d] [kind=%s]", source.startHeight, source.endHeight, kind))
+	logger.Info(fmt.Sprintf("starting pipeline backfill [start=%d] [end=%d] [kind=%s] [targets=%v]", source.startHeight, source.endHeight, kind, targetIds))
 
-	if err := p.pipeline.Start(ctxWithReport, source, sink, pipelineOptions); err != nil {
+	if err := p.pipeline.Start(ctxWithReport, source, p.withPayloadSinks(sink), pipelineOptions); err != nil {
 		return err
 	}
 
@@ -287,19 +612,133 @@ func (p *indexingPipeline) Backfill(ctx context.Context, backfillCfg BackfillCon
 	return err
 }
 
-func (p *indexingPipeline) canRunBackfill(isParallel bool) error {
-	if p.status.isPristine {
+func canRunBackfill(status *pipelineStatus) error {
+	if status.isPristine {
 		return ErrIsPristine
 	}
 
-	if !p.status.isUpToDate {
-		if isParallel && p.configParser.IsAnyVersionSequential(p.status.missingVersionIds) {
-			return ErrBackfillCannotBeRun
-		}
+	if status.isUpToDate {
+		return ErrNothingToBackfill
 	}
 	return nil
 }
 
+// getBackfillTargetIds returns targets which are not up to date, narrowed down to desired targets when given
+func getBackfillTargetIds(status *pipelineStatus, desiredTargetIds []int64) ([]int64, error) {
+	if len(desiredTargetIds) == 0 {
+		return status.missingTargetIds, nil
+	}
+
+	missing := make(map[int64]bool)
+	for _, targetId := range status.missingTargetIds {
+		missing[targetId] = true
+	}
+
+	var targetIds []int64
+	for _, targetId := range desiredTargetIds {
+		if missing[targetId] {
+			targetIds = append(targetIds, targetId)
+		}
+	}
+
+	if len(targetIds) == 0 {
+		return nil, ErrNothingToBackfill
+	}
+	return targetIds, nil
+}
+
+// getMissingHeightRange returns range of heights which includes all missing heights of given targets
+func getMissingHeightRange(coverage []TargetCoverage, targetIds []int64) (int64, int64) {
+	var startHeight, endHeight int64
+	found := false
+	for _, c := range coverage {
+		for _, targetId := range targetIds {
+			if c.TargetID != targetId {
+				continue
+			}
+			for _, r := range c.MissingRanges {
+				if !found || r.StartHeight < startHeight {
+					startHeight = r.StartHeight
+				}
+				if !found || r.EndHeight > endHeight {
+					endHeight = r.EndHeight
+				}
+				found = true
+			}
+		}
+	}
+	return startHeight, endHeight
+}
+
+type ReindexConfig struct {
+	StartHeight int64
+	EndHeight   int64
+	TargetIds   []int64
+}
+
+// Reindex re-runs given targets for already indexed range of heights.
+// Unlike backfill it does not change index versions of syncables.
+func (p *indexingPipeline) Reindex(ctx context.Context, reindexCfg ReindexConfig) error {
+	source, err := NewRangeSource(p.cfg, p.syncableDb, reindexCfg.StartHeight, reindexCfg.EndHeight)
+	if err != nil {
+		return err
+	}
+
+	p.prefetcher.setMaxHeight(source.endHeight)
+
+	targetIds := reindexCfg.TargetIds
+	if len(targetIds) == 0 {
+		targetIds = p.configParser.GetAllVersionedTargetIds()
+	}
+
+	sink := NewReindexSink(p.databaseDb, p.syncableDb, p.targetRangeDb, targetIds)
+
+	pipelineOptionsCreator := &pipelineOptionsCreator{
+		configParser:     p.configParser,
+		desiredTargetIds: reindexCfg.TargetIds,
+	}
+	if len(reindexCfg.TargetIds) == 0 {
+		pipelineOptionsCreator.desiredVersionIds = p.configParser.GetAllVersionedVersionIds()
+	}
+	pipelineOptions, err := pipelineOptionsCreator.parse()
+	if err != nil {
+		return err
+	}
+
+	reportCreator := &reportCreator{
+		kind:         model.ReportKindRangeReindex,
+		indexVersion: p.configParser.GetCurrentVersionId(),
+		startHeight:  source.startHeight,
+		endHeight:    source.endHeight,
+
+		checkpointInterval: p.reportCheckpointInterval,
+		reportDb:           p.reportDb,
+	}
+
+	if err := reportCreator.create(); err != nil {
+		return err
+	}
+	sink.reportCreator = reportCreator
+
+	defer p.closePayloadSinks()
+
+	ctxWithReport := context.WithValue(ctx, CtxReport, reportCreator.report)
+
+	logger.Info(fmt.Sprintf("starting pipeline reindex [start=%d] [end=%d] [targets=%v]", source.startHeight, source.endHeight, reindexCfg.TargetIds))
+
+	err = p.pipeline.Start(ctxWithReport, source, p.withPayloadSinks(sink), pipelineOptions)
+	if err != nil {
+		metric.IndexerTotalErrors.Inc()
+	}
+
+	logger.Info(fmt.Sprintf("pipeline completed [Err: %+v]", err))
+
+	if completeErr := reportCreator.complete(source.Len(), sink.successCount, err); completeErr != nil {
+		return completeErr
+	}
+	return err
+}
+
 type RunConfig struct {
 	Height            int64
 	DesiredVersionIDs []int64
@@ -326,6 +765,8 @@ func (p *indexingPipeline) Run(ctx context.Context, runCfg RunConfig) (*payload,
 		logger.Field("targets", runCfg.DesiredTargetIDs),
 	)
 
+	p.prefetcher.setMaxHeight(runCfg.Height)
+
 	runPayload, err := p.pipeline.Run(ctx, runCfg.Height, pipelineOptions)
 	if err != nil {
 		metric.IndexerTotalErrors.Inc()
@@ -338,7 +779,3 @@ func (p *indexingPipeline) Run(ctx context.Context, runCfg RunConfig) (*payload,
 	payload := runPayload.(*payload)
 	return payload, nil
 }
-
-func isTransient(error) bool {
-	return true
-}
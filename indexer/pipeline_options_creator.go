@@ -46,6 +46,7 @@ func (o *pipelineOptionsCreator) getTasksWhitelist() ([]pipeline.TaskName, error
 	return getUniqueTaskNames(taskWhitelist), nil
 }
 
+// getStagesBlacklist skips persistor stage on dry run, tasks of other stages only read from database
 func (o *pipelineOptionsCreator) getStagesBlacklist() []pipeline.StageName {
 	var stagesBlacklist []pipeline.StageName
 	if o.dry {
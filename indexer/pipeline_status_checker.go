@@ -7,61 +7,58 @@ import (
 	"github.com/figment-networks/polkadothub-indexer/store"
 )
 
-// pipelineStatusChecker checks if index version is up to date and what index versions are missing (are not up to date)
+// pipelineStatusChecker checks if targets are up to date and which targets are missing (are not up to date)
 type pipelineStatusChecker struct {
 	syncablesDb         store.Syncables
 	currentIndexVersion int64
+	coverageChecker     *targetCoverageChecker
 }
 
 type pipelineStatus struct {
 	// isPristine true when database is empty
 	isPristine bool
-	// isUpToDate true when all syncables index version is the same as current index version
-	isUpToDate        bool
-	missingVersionIds []int64
+	// isUpToDate true when all targets processed the same heights
+	isUpToDate       bool
+	missingTargetIds []int64
+	// targetsCoverage holds coverage of targets which are not up to date
+	targetsCoverage []TargetCoverage
 }
 
 func (o *pipelineStatusChecker) getStatus() (*pipelineStatus, error) {
-	var startIndexVersion int64
-	var isUpToDate bool
-	var isPristine bool
-
 	smallestIndexVersion, err := o.syncablesDb.FindSmallestIndexVersion()
 	if err != nil {
 		if err == store.ErrNotFound {
-			// When syncables not found in databases, set start version to first
-			startIndexVersion = 1
-			isUpToDate = false
-			isPristine = true
-		} else {
-			return nil, err
-		}
-	} else {
-		if *smallestIndexVersion < o.currentIndexVersion {
-			// There are records with smaller index versions
-			// Include tasks from missing versions
-			startIndexVersion = *smallestIndexVersion + 1
-			isUpToDate = false
-		} else if *smallestIndexVersion == o.currentIndexVersion {
-			// When everything is up to date, set start version to first
-			startIndexVersion = 1
-			isUpToDate = true
-		} else {
-			return nil, errors.New(fmt.Sprintf("current index version %d is too small", o.currentIndexVersion))
+			// When syncables not found in databases, all targets are missing
+			return &pipelineStatus{
+				isPristine:       true,
+				isUpToDate:       false,
+				missingTargetIds: o.coverageChecker.configParser.GetAllVersionedTargetIds(),
+			}, nil
 		}
+		return nil, err
 	}
 
-	return &pipelineStatus{
-		isPristine:        isPristine,
-		isUpToDate:        isUpToDate,
-		missingVersionIds: o.getVersionIdsFrom(startIndexVersion),
-	}, nil
-}
+	if *smallestIndexVersion > o.currentIndexVersion {
+		return nil, errors.New(fmt.Sprintf("current index version %d is too small", o.currentIndexVersion))
+	}
 
-func (o *pipelineStatusChecker) getVersionIdsFrom(startIndexVersion int64) []int64 {
-	var ids []int64
-	for i := startIndexVersion; i <= o.currentIndexVersion; i++ {
-		ids = append(ids, i)
+	if err := o.coverageChecker.seed(); err != nil {
+		return nil, err
 	}
-	return ids
+
+	coverage, err := o.coverageChecker.getCoverage()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &pipelineStatus{}
+	for _, c := range coverage {
+		if !c.IsComplete() {
+			status.missingTargetIds = append(status.missingTargetIds, c.TargetID)
+			status.targetsCoverage = append(status.targetsCoverage, c)
+		}
+	}
+	status.isUpToDate = len(status.missingTargetIds) == 0
+
+	return status, nil
 }
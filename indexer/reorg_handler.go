@@ -0,0 +1,126 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/metric"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+const (
+	maxReorgDepth = 256
+)
+
+var (
+	ErrCommonAncestorNotFound = errors.New("could not find common ancestor of indexed and canonical chain")
+)
+
+// ReorgError is returned when parent of indexed height was replaced by a fork
+type ReorgError struct {
+	Height             int64
+	ExpectedParentHash string
+	ActualParentHash   string
+}
+
+func (e *ReorgError) Error() string {
+	return fmt.Sprintf("chain reorganization detected [height=%d] [expected_parent_hash=%s] [actual_parent_hash=%s]", e.Height, e.ExpectedParentHash, e.ActualParentHash)
+}
+
+// reorgHandler rolls back indexed data above the common ancestor of indexed and canonical chain
+type reorgHandler struct {
+	cfg          *config.Config
+	client       client.BlockClient
+	indexVersion int64
+
+	databaseDb    store.Database
+	reportDb      store.Reports
+	syncableDb    store.Syncables
+	systemEventDb store.SystemEvents
+}
+
+// handle rolls back indexed data above common ancestor and returns the ancestor, from which indexing should continue
+func (h *reorgHandler) handle(reorgErr *ReorgError) (*model.Syncable, error) {
+	metric.IndexerTotalReorgs.Inc()
+
+	ancestor, err := h.findCommonAncestor(reorgErr.Height - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	mostRecent, err := h.syncableDb.FindMostRecent()
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(fmt.Sprintf("rolling back chain reorganization [fork_height=%d] [common_ancestor=%d] [most_recent=%d]", reorgErr.Height, ancestor.Height, mostRecent.Height))
+
+	reportCreator := &reportCreator{
+		kind:         model.ReportKindRollback,
+		indexVersion: h.indexVersion,
+		startHeight:  ancestor.Height + 1,
+		endHeight:    mostRecent.Height,
+		reportDb:     h.reportDb,
+	}
+	if err := reportCreator.create(); err != nil {
+		return nil, err
+	}
+
+	count := mostRecent.Height - ancestor.Height
+
+	err = h.databaseDb.RollbackAfterHeight(ancestor.Height, ancestor.Time.Time)
+	if err == nil {
+		err = h.createSystemEvent(ancestor, mostRecent, reorgErr)
+	}
+	if err != nil {
+		if completeErr := reportCreator.complete(count, 0, err); completeErr != nil {
+			logger.Error(completeErr)
+		}
+		return nil, err
+	}
+
+	return ancestor, reportCreator.complete(count, count, nil)
+}
+
+// findCommonAncestor finds most recent indexed syncable which is still part of canonical chain
+func (h *reorgHandler) findCommonAncestor(height int64) (*model.Syncable, error) {
+	for i := height; i >= h.cfg.FirstBlockHeight && height-i < maxReorgDepth; i-- {
+		syncable, err := h.syncableDb.FindByHeight(i)
+		if err != nil {
+			return nil, err
+		}
+
+		if syncable.Hash == "" {
+			// hash was not recorded for this height so there is nothing to compare with
+			return syncable, nil
+		}
+
+		resp, err := h.client.GetByHeight(i)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.GetBlock().GetBlockHash() == syncable.Hash {
+			return syncable, nil
+		}
+	}
+	return nil, ErrCommonAncestorNotFound
+}
+
+func (h *reorgHandler) createSystemEvent(ancestor, mostRecent *model.Syncable, reorgErr *ReorgError) error {
+	systemEvent, err := newSystemEvent("", ancestor, model.SystemEventChainReorg, model.ChainReorgData{
+		ForkHeight:           reorgErr.Height,
+		CommonAncestorHeight: ancestor.Height,
+		MostRecentHeight:     mostRecent.Height,
+		ExpectedParentHash:   reorgErr.ExpectedParentHash,
+		ActualParentHash:     reorgErr.ActualParentHash,
+	})
+	if err != nil {
+		return err
+	}
+	return h.systemEventDb.BulkUpsert([]model.SystemEvent{systemEvent})
+}
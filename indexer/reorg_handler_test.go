@@ -0,0 +1,172 @@
+package indexer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	mockClient "github.com/figment-networks/polkadothub-indexer/mock/client"
+	mock "github.com/figment-networks/polkadothub-indexer/mock/store"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-proxy/grpc/block/blockpb"
+	"github.com/golang/mock/gomock"
+)
+
+func TestReorgHandler_findCommonAncestor(t *testing.T) {
+	t.Run("returns first syncable matching canonical chain", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		syncableDbMock := mock.NewMockSyncables(ctrl)
+		clientMock := mockClient.NewMockBlockClient(ctrl)
+
+		syncableDbMock.EXPECT().FindByHeight(int64(19)).Return(&model.Syncable{Height: 19, Hash: "fork19"}, nil).Times(1)
+		syncableDbMock.EXPECT().FindByHeight(int64(18)).Return(&model.Syncable{Height: 18, Hash: "hash18"}, nil).Times(1)
+		clientMock.EXPECT().GetByHeight(int64(19)).Return(&blockpb.GetByHeightResponse{Block: &blockpb.Block{BlockHash: "hash19"}}, nil).Times(1)
+		clientMock.EXPECT().GetByHeight(int64(18)).Return(&blockpb.GetByHeightResponse{Block: &blockpb.Block{BlockHash: "hash18"}}, nil).Times(1)
+
+		handler := reorgHandler{
+			cfg:        testCfg,
+			client:     clientMock,
+			syncableDb: syncableDbMock,
+		}
+
+		ancestor, err := handler.findCommonAncestor(19)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		if ancestor.Height != 18 {
+			t.Errorf("unexpected common ancestor, want: %d; got: %d", 18, ancestor.Height)
+		}
+	})
+
+	t.Run("returns syncable without recorded hash", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		syncableDbMock := mock.NewMockSyncables(ctrl)
+		clientMock := mockClient.NewMockBlockClient(ctrl)
+
+		syncableDbMock.EXPECT().FindByHeight(int64(19)).Return(&model.Syncable{Height: 19}, nil).Times(1)
+
+		handler := reorgHandler{
+			cfg:        testCfg,
+			client:     clientMock,
+			syncableDb: syncableDbMock,
+		}
+
+		ancestor, err := handler.findCommonAncestor(19)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		if ancestor.Height != 19 {
+			t.Errorf("unexpected common ancestor, want: %d; got: %d", 19, ancestor.Height)
+		}
+	})
+
+	t.Run("returns error when no syncable matches canonical chain", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		syncableDbMock := mock.NewMockSyncables(ctrl)
+		clientMock := mockClient.NewMockBlockClient(ctrl)
+
+		syncableDbMock.EXPECT().FindByHeight(int64(1)).Return(&model.Syncable{Height: 1, Hash: "fork1"}, nil).Times(1)
+		clientMock.EXPECT().GetByHeight(int64(1)).Return(&blockpb.GetByHeightResponse{Block: &blockpb.Block{BlockHash: "hash1"}}, nil).Times(1)
+
+		handler := reorgHandler{
+			cfg:        testCfg,
+			client:     clientMock,
+			syncableDb: syncableDbMock,
+		}
+
+		if _, err := handler.findCommonAncestor(1); err != ErrCommonAncestorNotFound {
+			t.Errorf("unexpected error, want: %v; got: %v", ErrCommonAncestorNotFound, err)
+		}
+	})
+}
+
+func TestReorgHandler_handle(t *testing.T) {
+	ancestorTime := *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC))
+
+	t.Run("rolls back data above common ancestor at once and returns ancestor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		databaseDbMock := mock.NewMockDatabase(ctrl)
+		reportDbMock := mock.NewMockReports(ctrl)
+		syncableDbMock := mock.NewMockSyncables(ctrl)
+		systemEventDbMock := mock.NewMockSystemEvents(ctrl)
+		clientMock := mockClient.NewMockBlockClient(ctrl)
+
+		syncableDbMock.EXPECT().FindByHeight(int64(19)).Return(&model.Syncable{Height: 19, Time: ancestorTime}, nil).Times(1)
+		syncableDbMock.EXPECT().FindMostRecent().Return(&model.Syncable{Height: 22}, nil).Times(1)
+		reportDbMock.EXPECT().Create(gomock.Any()).DoAndReturn(setTestReportModel).Times(1)
+		databaseDbMock.EXPECT().RollbackAfterHeight(int64(19), ancestorTime.Time).Return(nil).Times(1)
+		systemEventDbMock.EXPECT().BulkUpsert(gomock.Any()).Return(nil).Times(1)
+		reportDbMock.EXPECT().Save(gomock.Any()).Return(nil).Times(1)
+
+		handler := reorgHandler{
+			cfg:           testCfg,
+			client:        clientMock,
+			databaseDb:    databaseDbMock,
+			reportDb:      reportDbMock,
+			syncableDb:    syncableDbMock,
+			systemEventDb: systemEventDbMock,
+		}
+
+		ancestor, err := handler.handle(&ReorgError{Height: 20})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		if ancestor.Height != 19 {
+			t.Errorf("unexpected common ancestor, want: %d; got: %d", 19, ancestor.Height)
+		}
+	})
+
+	t.Run("returns error when rollback fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		databaseDbMock := mock.NewMockDatabase(ctrl)
+		reportDbMock := mock.NewMockReports(ctrl)
+		syncableDbMock := mock.NewMockSyncables(ctrl)
+		systemEventDbMock := mock.NewMockSystemEvents(ctrl)
+		clientMock := mockClient.NewMockBlockClient(ctrl)
+
+		rollbackErr := errors.New("test err")
+
+		syncableDbMock.EXPECT().FindByHeight(int64(19)).Return(&model.Syncable{Height: 19, Time: ancestorTime}, nil).Times(1)
+		syncableDbMock.EXPECT().FindMostRecent().Return(&model.Syncable{Height: 22}, nil).Times(1)
+		reportDbMock.EXPECT().Create(gomock.Any()).DoAndReturn(setTestReportModel).Times(1)
+		databaseDbMock.EXPECT().RollbackAfterHeight(int64(19), ancestorTime.Time).Return(rollbackErr).Times(1)
+		systemEventDbMock.EXPECT().BulkUpsert(gomock.Any()).Times(0)
+		reportDbMock.EXPECT().Save(gomock.Any()).Return(nil).Times(1)
+
+		handler := reorgHandler{
+			cfg:           testCfg,
+			client:        clientMock,
+			databaseDb:    databaseDbMock,
+			reportDb:      reportDbMock,
+			syncableDb:    syncableDbMock,
+			systemEventDb: systemEventDbMock,
+		}
+
+		if _, err := handler.handle(&ReorgError{Height: 20}); err != rollbackErr {
+			t.Errorf("unexpected error, want: %v; got: %v", rollbackErr, err)
+		}
+	})
+}
+
+// setTestReportModel sets model of created report like database does
+func setTestReportModel(record interface{}) error {
+	record.(*model.Report).Model = &model.Model{CreatedAt: *types.NewTimeFromTime(time.Now())}
+	return nil
+}
@@ -3,6 +3,7 @@ package indexer
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/figment-networks/polkadothub-indexer/model"
 	"github.com/figment-networks/polkadothub-indexer/store"
@@ -15,9 +16,16 @@ type reportCreator struct {
 	startHeight  int64
 	endHeight    int64
 
+	// checkpointInterval is minimal interval between checkpoints of report progress, 0 disables checkpoints
+	checkpointInterval time.Duration
+
 	reportDb store.Reports
 
 	report *model.Report
+
+	startedAt           time.Time
+	lastCheckpointAt    time.Time
+	resumedSuccessCount int64
 }
 
 func (o *reportCreator) createIfNotExists(kinds ...model.ReportKind) error {
@@ -35,6 +43,10 @@ func (o *reportCreator) createIfNotExists(kinds ...model.ReportKind) error {
 			return errors.New(fmt.Sprintf("there is already reindexing in process [kind=%s] (use -force flag to override it)", report.Kind))
 		}
 		o.report = report
+		if report.SuccessCount != nil {
+			o.resumedSuccessCount = *report.SuccessCount
+		}
+		o.start()
 	}
 	return nil
 }
@@ -52,12 +64,44 @@ func (o *reportCreator) create() error {
 	}
 
 	o.report = report
+	o.start()
 
 	return nil
 }
 
+func (o *reportCreator) start() {
+	o.startedAt = time.Now()
+	o.lastCheckpointAt = o.startedAt
+}
+
+// resumeHeight returns height following last checkpoint of not completed report, 0 when there is no checkpoint
+func (o *reportCreator) resumeHeight() int64 {
+	if o.report == nil || o.report.IsCompleted() || o.report.LastHeight == nil {
+		return 0
+	}
+	return *o.report.LastHeight + 1
+}
+
+// checkpoint saves progress of report when checkpoint interval passed since last checkpoint
+func (o *reportCreator) checkpoint(lastHeight int64, successCount int64) error {
+	now := time.Now()
+	if o.checkpointInterval <= 0 || now.Sub(o.lastCheckpointAt) < o.checkpointInterval {
+		return nil
+	}
+
+	var heightsPerSecond float64
+	if elapsed := now.Sub(o.startedAt).Seconds(); elapsed > 0 {
+		heightsPerSecond = float64(successCount) / elapsed
+	}
+
+	o.report.Checkpoint(lastHeight, o.resumedSuccessCount+successCount, heightsPerSecond)
+	o.lastCheckpointAt = now
+
+	return o.reportDb.Save(o.report)
+}
+
 func (o *reportCreator) complete(totalCount int64, successCount int64, err error) error {
-	o.report.Complete(successCount, totalCount-successCount, err)
+	o.report.Complete(o.resumedSuccessCount+successCount, totalCount-successCount, err)
 
 	return o.reportDb.Save(o.report)
 }
@@ -170,6 +170,116 @@ func TestReportCreator_complete(t *testing.T) {
 	})
 }
 
+func TestReportCreator_checkpoint(t *testing.T) {
+	t.Run("when checkpoint interval did not pass, report is not saved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reportStoreMock := mock.NewMockReports(ctrl)
+
+		reportStoreMock.EXPECT().Save(gomock.Any()).Times(0)
+
+		creator := reportCreator{
+			checkpointInterval: time.Hour,
+			report:             getTestReport(model.ReportKindSequentialReindex),
+			reportDb:           reportStoreMock,
+			lastCheckpointAt:   time.Now(),
+		}
+
+		if err := creator.checkpoint(10, 10); err != nil {
+			t.Errorf("checkpoint() should not return error, got: %v", err)
+		}
+		if creator.report.LastHeight != nil {
+			t.Errorf("last height should be nil, got: %d", *creator.report.LastHeight)
+		}
+	})
+
+	t.Run("when checkpoints are disabled, report is not saved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reportStoreMock := mock.NewMockReports(ctrl)
+
+		reportStoreMock.EXPECT().Save(gomock.Any()).Times(0)
+
+		creator := reportCreator{
+			report:   getTestReport(model.ReportKindSequentialReindex),
+			reportDb: reportStoreMock,
+		}
+
+		if err := creator.checkpoint(10, 10); err != nil {
+			t.Errorf("checkpoint() should not return error, got: %v", err)
+		}
+	})
+
+	t.Run("when checkpoint interval passed, progress and estimate are saved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reportStoreMock := mock.NewMockReports(ctrl)
+
+		reportStoreMock.EXPECT().Save(gomock.Any()).Return(nil).Times(1)
+
+		report := getTestReport(model.ReportKindSequentialReindex)
+		report.StartHeight = 1
+		report.EndHeight = 100
+
+		now := time.Now()
+		creator := reportCreator{
+			checkpointInterval:  time.Second,
+			report:              report,
+			reportDb:            reportStoreMock,
+			startedAt:           now.Add(-10 * time.Second),
+			lastCheckpointAt:    now.Add(-2 * time.Second),
+			resumedSuccessCount: 5,
+		}
+
+		if err := creator.checkpoint(50, 20); err != nil {
+			t.Errorf("checkpoint() should not return error, got: %v", err)
+			return
+		}
+
+		if report.LastHeight == nil || *report.LastHeight != 50 {
+			t.Errorf("unexpected last height: %v", report.LastHeight)
+		}
+		if report.SuccessCount == nil || *report.SuccessCount != 25 {
+			t.Errorf("unexpected success count: %v", report.SuccessCount)
+		}
+		if report.HeightsPerSecond == nil || *report.HeightsPerSecond <= 0 || *report.HeightsPerSecond > 2 {
+			t.Errorf("unexpected heights per second: %v", report.HeightsPerSecond)
+		}
+		if report.EstimatedCompletedAt == nil || !report.EstimatedCompletedAt.After(now) {
+			t.Errorf("unexpected estimated completed at: %v", report.EstimatedCompletedAt)
+		}
+	})
+}
+
+func TestReportCreator_resumeHeight(t *testing.T) {
+	lastHeight := int64(50)
+	completedAt := types.NewTimeFromTime(time.Now())
+
+	tests := []struct {
+		description string
+		report      *model.Report
+		expect      int64
+	}{
+		{"no report", nil, 0},
+		{"report without checkpoint", &model.Report{}, 0},
+		{"report with checkpoint", &model.Report{LastHeight: &lastHeight}, 51},
+		{"completed report", &model.Report{LastHeight: &lastHeight, CompletedAt: completedAt}, 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			creator := reportCreator{report: tt.report}
+			if got := creator.resumeHeight(); got != tt.expect {
+				t.Errorf("unexpected resume height, want: %d; got: %d", tt.expect, got)
+			}
+		})
+	}
+}
+
 func getTestReport(kind model.ReportKind) *model.Report {
 	return &model.Report{
 		Model: &model.Model{
@@ -0,0 +1,101 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/polkadothub-indexer/metric"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+var (
+	_ pipeline.Task = (*retryingTask)(nil)
+
+	// retryPolicies defines how errors of given class are retried. Errors of classes without policy are not retried.
+	retryPolicies = map[ErrorClass]retryPolicy{
+		ErrorClassNetwork:  {maxAttempts: 5, initialBackoff: 500 * time.Millisecond, maxBackoff: 10 * time.Second},
+		ErrorClassDatabase: {maxAttempts: 3, initialBackoff: 250 * time.Millisecond, maxBackoff: 5 * time.Second},
+	}
+)
+
+type retryPolicy struct {
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// backoff returns time to wait before given attempt
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.initialBackoff
+	for i := 1; i < attempt && backoff < p.maxBackoff; i++ {
+		backoff = backoff * 2
+	}
+	if backoff > p.maxBackoff {
+		backoff = p.maxBackoff
+	}
+	return backoff
+}
+
+// RetryingTask retries task with exponential backoff for as long as retry policy of error class allows it
+func RetryingTask(task pipeline.Task) pipeline.Task {
+	return &retryingTask{
+		task:     task,
+		policies: retryPolicies,
+	}
+}
+
+type retryingTask struct {
+	task     pipeline.Task
+	policies map[ErrorClass]retryPolicy
+}
+
+// GetName returns name of wrapped task so that task can be found in whitelist
+func (t *retryingTask) GetName() string {
+	return t.task.GetName()
+}
+
+func (t *retryingTask) Run(ctx context.Context, p pipeline.Payload) error {
+	return retry(ctx, t.policies, t.GetName(), func() error {
+		return t.task.Run(ctx, p)
+	})
+}
+
+// retry calls fn with exponential backoff for as long as retry policy of error class allows it, name is name of task
+// which is reported in retry metrics
+func retry(ctx context.Context, policies map[ErrorClass]retryPolicy, name string, fn func() error) error {
+	attempts := map[ErrorClass]int{}
+
+	for {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		class := classifyError(err)
+		attempts[class]++
+
+		policy, ok := policies[class]
+		if !ok || attempts[class] >= policy.maxAttempts {
+			return &IndexerError{Class: class, Err: err}
+		}
+
+		backoff := policy.backoff(attempts[class])
+
+		metric.IndexerTaskRetries.WithLabelValues(name, class.String()).Inc()
+		logger.Info(fmt.Sprintf("retrying indexer task [task=%s] [class=%s] [attempt=%d] [backoff=%s] [err=%+v]", name, class, attempts[class], backoff, err))
+
+		select {
+		case <-ctx.Done():
+			return &IndexerError{Class: class, Err: err}
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// isTransientError returns true when error is of class which is retried, so it can succeed when height is indexed again
+func isTransientError(err error) bool {
+	_, ok := retryPolicies[classifyError(err)]
+	return ok
+}
@@ -0,0 +1,97 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type failingTask struct {
+	errs  []error
+	calls int
+}
+
+func (t *failingTask) GetName() string {
+	return "FailingTask"
+}
+
+func (t *failingTask) Run(ctx context.Context, p pipeline.Payload) error {
+	t.calls++
+	if t.calls > len(t.errs) {
+		return nil
+	}
+	return t.errs[t.calls-1]
+}
+
+func TestRetryingTask_Run(t *testing.T) {
+	networkErr := status.Error(codes.Unavailable, "test")
+	databaseErr := &IndexerError{Class: ErrorClassDatabase, Err: errors.New("test")}
+	validationErr := ErrBlockSequenceNotValid
+
+	policies := map[ErrorClass]retryPolicy{
+		ErrorClassNetwork:  {maxAttempts: 3, initialBackoff: time.Millisecond, maxBackoff: time.Millisecond},
+		ErrorClassDatabase: {maxAttempts: 2, initialBackoff: time.Millisecond, maxBackoff: time.Millisecond},
+	}
+
+	tests := []struct {
+		description string
+		errs        []error
+		expectCalls int
+		expectClass ErrorClass
+		expectErr   bool
+	}{
+		{"succeeds without retries", nil, 1, 0, false},
+		{"retries network errors until success", []error{networkErr, networkErr}, 3, 0, false},
+		{"stops after max attempts for network errors", []error{networkErr, networkErr, networkErr}, 3, ErrorClassNetwork, true},
+		{"counts attempts per error class", []error{networkErr, databaseErr, networkErr}, 4, 0, false},
+		{"stops after max attempts for database errors", []error{databaseErr, databaseErr}, 2, ErrorClassDatabase, true},
+		{"does not retry validation errors", []error{validationErr}, 1, ErrorClassValidation, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			task := &failingTask{errs: tt.errs}
+			retrying := &retryingTask{task: task, policies: policies}
+
+			err := retrying.Run(context.Background(), &payload{})
+			if tt.expectErr {
+				var indexerErr *IndexerError
+				if !errors.As(err, &indexerErr) {
+					t.Errorf("want IndexerError; got %v", err)
+					return
+				}
+				if indexerErr.Class != tt.expectClass {
+					t.Errorf("unexpected error class, want: %s; got: %s", tt.expectClass, indexerErr.Class)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if task.calls != tt.expectCalls {
+				t.Errorf("unexpected number of calls, want: %d; got: %d", tt.expectCalls, task.calls)
+			}
+		})
+	}
+
+	t.Run("returns name of wrapped task", func(t *testing.T) {
+		if name := RetryingTask(&failingTask{}).GetName(); name != "FailingTask" {
+			t.Errorf("unexpected name, want: %s; got: %s", "FailingTask", name)
+		}
+	})
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := retryPolicy{initialBackoff: time.Second, maxBackoff: 5 * time.Second}
+
+	for attempt, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second, 10: 5 * time.Second} {
+		if got := policy.backoff(attempt); got != want {
+			t.Errorf("unexpected backoff for attempt %d, want: %s; got: %s", attempt, want, got)
+		}
+	}
+}
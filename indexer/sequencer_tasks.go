@@ -3,14 +3,17 @@ package indexer
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/polkadothub-indexer/client"
 	"github.com/figment-networks/polkadothub-indexer/config"
 	"github.com/figment-networks/polkadothub-indexer/metric"
 	"github.com/figment-networks/polkadothub-indexer/model"
 	"github.com/figment-networks/polkadothub-indexer/store"
 	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+	"github.com/figment-networks/polkadothub-proxy/grpc/staking/stakingpb"
 )
 
 const (
@@ -23,6 +26,8 @@ const (
 	TransactionSeqCreatorTaskName      = "TransactionSeqCreator"
 	RewardEraSeqCreatorTaskName        = "RewardEraSeqCreator"
 	ClaimedRewardEraSeqCreatorTaskName = "ClaimedRewardEraSeqCreator"
+	SlashSeqCreatorTaskName            = "SlashSeqCreator"
+	AccountBalanceSeqCreatorTaskName   = "AccountBalanceSeqCreator"
 )
 
 var (
@@ -330,6 +335,197 @@ func (t *transactionSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload)
 	return nil
 }
 
+// NewSlashSeqCreatorTask creates slash sequences. Slashes are deferred, so they are attributed to validators
+// by exposures of era in which they were reported
+func NewSlashSeqCreatorTask(cfg *config.Config, stakingClient client.StakingClient, syncablesDb store.Syncables) *slashSeqCreatorTask {
+	return &slashSeqCreatorTask{
+		cfg:           cfg,
+		stakingClient: stakingClient,
+		syncablesDb:   syncablesDb,
+	}
+}
+
+type slashSeqCreatorTask struct {
+	cfg           *config.Config
+	stakingClient client.StakingClient
+	syncablesDb   store.Syncables
+}
+
+func (t *slashSeqCreatorTask) GetName() string {
+	return SlashSeqCreatorTaskName
+}
+
+func (t *slashSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	defer metric.LogIndexerTaskDuration(time.Now(), t.GetName())
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSequencer, t.GetName(), payload.CurrentHeight))
+
+	var slashEraStaking *stakingpb.Staking
+	for _, rawEvent := range payload.RawEvents {
+		if isSlashEvent(rawEvent) {
+			var err error
+			if slashEraStaking, err = t.getSlashEraStaking(payload); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	mappedSlashSeqs, err := ToSlashSequences(payload.Syncable, payload.RawEvents, slashEraStaking)
+	if err != nil {
+		return err
+	}
+
+	payload.SlashSequences = mappedSlashSeqs
+
+	return nil
+}
+
+// getSlashEraStaking returns staking of era in which slashes applied at height were reported,
+// staking of current era is used when slashes are not deferred or reporting era is not indexed
+func (t *slashSeqCreatorTask) getSlashEraStaking(payload *payload) (*stakingpb.Staking, error) {
+	if t.cfg.SlashDeferDuration == 0 {
+		return payload.RawStaking, nil
+	}
+
+	slashEra := payload.Syncable.Era - t.cfg.SlashDeferDuration
+	lastSyncableInSlashEra, err := t.syncablesDb.FindLastInEra(slashEra)
+	if err != nil {
+		if err == store.ErrNotFound {
+			logger.Info(fmt.Sprintf("slash era is not indexed, attributing slashes by current exposures [era=%d] [height=%d]", slashEra, payload.CurrentHeight))
+			return payload.RawStaking, nil
+		}
+		return nil, err
+	}
+
+	res, err := t.stakingClient.GetByHeight(lastSyncableInSlashEra.Height)
+	if err != nil {
+		return nil, err
+	}
+	return res.GetStaking(), nil
+}
+
+// NewAccountBalanceSeqCreatorTask creates balance snapshots of accounts touched by balances or staking events at height
+// and of validators and their nominators at the end of era. Balances are fetched concurrently by configured number of workers
+func NewAccountBalanceSeqCreatorTask(cfg *config.Config, accountClient client.AccountClient) *accountBalanceSeqCreatorTask {
+	workers := cfg.AccountBalanceFetchWorkers
+	if workers < 1 {
+		workers = 1
+	}
+	return &accountBalanceSeqCreatorTask{
+		accountClient: accountClient,
+		workers:       workers,
+		policies:      retryPolicies,
+	}
+}
+
+type accountBalanceSeqCreatorTask struct {
+	accountClient client.AccountClient
+	workers       int64
+	policies      map[ErrorClass]retryPolicy
+}
+
+func (t *accountBalanceSeqCreatorTask) GetName() string {
+	return AccountBalanceSeqCreatorTaskName
+}
+
+func (t *accountBalanceSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	defer metric.LogIndexerTaskDuration(time.Now(), t.GetName())
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSequencer, t.GetName(), payload.CurrentHeight))
+
+	rawAccounts := getBalanceEventAccounts(payload.RawEvents)
+	if payload.Syncable.LastInEra {
+		for _, stakingValidator := range payload.RawStaking.GetValidators() {
+			rawAccounts = append(rawAccounts, stakingValidator.GetStashAccount())
+			for _, staker := range stakingValidator.GetStakers() {
+				rawAccounts = append(rawAccounts, staker.GetStashAccount())
+			}
+		}
+	}
+
+	var accounts []string
+	seen := make(map[string]bool, len(rawAccounts))
+	for _, account := range rawAccounts {
+		if account == "" || seen[account] {
+			continue
+		}
+		seen[account] = true
+		accounts = append(accounts, account)
+	}
+
+	balanceSeqs, err := t.getBalanceSeqs(ctx, payload.Syncable, accounts)
+	if err != nil {
+		return err
+	}
+	payload.AccountBalanceSequences = append(payload.AccountBalanceSequences, balanceSeqs...)
+
+	return nil
+}
+
+// getBalanceSeqs fetches balances of accounts by bounded number of workers, each fetch is retried on transient errors.
+// Balance sequences are returned in order of accounts, only when balance of every account was fetched
+func (t *accountBalanceSeqCreatorTask) getBalanceSeqs(ctx context.Context, syncable *model.Syncable, accounts []string) ([]model.AccountBalanceSeq, error) {
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	balanceSeqs := make([]model.AccountBalanceSeq, len(accounts))
+	fetched := make([]bool, len(accounts))
+	errs := make([]error, len(accounts))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := int64(0); w < t.workers && w < int64(len(accounts)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = retry(fetchCtx, t.policies, t.GetName(), func() error {
+					res, err := t.accountClient.GetByHeight(accounts[i], syncable.Height)
+					if err != nil {
+						return err
+					}
+					balanceSeqs[i], err = ToAccountBalanceSequence(syncable, accounts[i], res.GetAccount())
+					return err
+				})
+				if errs[i] != nil {
+					cancel()
+					continue
+				}
+				fetched[i] = true
+			}
+		}()
+	}
+
+	for i := range accounts {
+		if fetchCtx.Err() != nil {
+			break
+		}
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, ok := range fetched {
+		if !ok {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return nil, context.Canceled
+		}
+	}
+	return balanceSeqs, nil
+}
+
 // NewRewardEraSeqCreatorTask creates rewards
 func NewRewardEraSeqCreatorTask(cfg *config.Config, syncablesDb store.Syncables) *rewardEraSeqCreatorTask {
 	return &rewardEraSeqCreatorTask{cfg, syncablesDb}
@@ -2,16 +2,24 @@ package indexer
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
 
+	mock_client "github.com/figment-networks/polkadothub-indexer/mock/client"
 	mock "github.com/figment-networks/polkadothub-indexer/mock/store"
 	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
 	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/polkadothub-proxy/grpc/event/eventpb"
+	"github.com/figment-networks/polkadothub-proxy/grpc/staking/stakingpb"
 	"github.com/figment-networks/polkadothub-proxy/grpc/validator/validatorpb"
 
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestValidatorSeqCreator_Run(t *testing.T) {
@@ -198,3 +206,310 @@ func TestRewardEraSeqCreatorTask_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestSlashSeqCreatorTask_Run(t *testing.T) {
+	syncable := &model.Syncable{
+		Height: 20,
+		Era:    182,
+		Time:   *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC)),
+	}
+
+	// Slashes applied in era 182 were reported in era 154
+	cfg := *testCfg
+	cfg.SlashDeferDuration = 28
+	lastSyncableInSlashEra := &model.Syncable{Height: 5, Era: 154}
+
+	slashEraStaking := &stakingpb.Staking{
+		Validators: []*stakingpb.Validator{
+			{StashAccount: "validator1", Stakers: []*stakingpb.Stake{{StashAccount: "nominator1"}, {StashAccount: "nominator2"}}},
+			{StashAccount: "validator2", Stakers: []*stakingpb.Stake{{StashAccount: "nominator3"}}},
+		},
+	}
+	// Slashed validators are often chilled, so they are missing in exposures of current era
+	currentStaking := &stakingpb.Staking{
+		Validators: []*stakingpb.Validator{
+			{StashAccount: "validator3", Stakers: []*stakingpb.Stake{{StashAccount: "nominator1"}}},
+		},
+	}
+
+	slashEvent := func(index int64, method, stash, amount string) *eventpb.Event {
+		return &eventpb.Event{Index: index, Section: "staking", Method: method, Data: []*eventpb.EventData{{Name: "AccountId", Value: stash}, {Name: "Balance", Value: amount}}}
+	}
+
+	type expectedSeq struct {
+		kind      model.SlashKind
+		stash     string
+		validator string
+		amount    int64
+	}
+
+	tests := []struct {
+		description      string
+		events           []*eventpb.Event
+		slashEraNotFound bool
+		expect           []expectedSeq
+		expectErr        error
+	}{
+		{
+			description: "attributes slashed nominators to validator slashed before them",
+			events: []*eventpb.Event{
+				slashEvent(1, "Slash", "validator1", "100"),
+				slashEvent(2, "Slash", "nominator1", "10"),
+				slashEvent(3, "Slashed", "nominator2", "20"),
+			},
+			expect: []expectedSeq{
+				{model.SlashKindSlash, "validator1", "validator1", 100},
+				{model.SlashKindSlash, "nominator1", "validator1", 10},
+				{model.SlashKindSlash, "nominator2", "validator1", 20},
+			},
+		},
+		{
+			description: "attributes slashes by exposures of slash era",
+			events: []*eventpb.Event{
+				slashEvent(1, "Slash", "validator1", "100"),
+				slashEvent(2, "Slash", "validator2", "50"),
+				slashEvent(3, "Slash", "nominator3", "5"),
+				slashEvent(4, "Slash", "nominator1", "10"),
+			},
+			expect: []expectedSeq{
+				{model.SlashKindSlash, "validator1", "validator1", 100},
+				{model.SlashKindSlash, "validator2", "validator2", 50},
+				{model.SlashKindSlash, "nominator3", "validator2", 5},
+				{model.SlashKindSlash, "nominator1", "nominator1", 10},
+			},
+		},
+		{
+			description: "attributes accounts which are not exposed to last slashed validator to themselves",
+			events: []*eventpb.Event{
+				slashEvent(1, "Slash", "validator9", "100"),
+				slashEvent(2, "Slash", "validator8", "50"),
+				slashEvent(3, "Slash", "validator1", "10"),
+				slashEvent(4, "Slash", "nominator1", "1"),
+			},
+			expect: []expectedSeq{
+				{model.SlashKindSlash, "validator9", "validator9", 100},
+				{model.SlashKindSlash, "validator8", "validator8", 50},
+				{model.SlashKindSlash, "validator1", "validator1", 10},
+				{model.SlashKindSlash, "nominator1", "validator1", 1},
+			},
+		},
+		{
+			description: "attributes slashes by current exposures when slash era is not indexed",
+			events: []*eventpb.Event{
+				slashEvent(1, "Slash", "validator3", "100"),
+				slashEvent(2, "Slash", "nominator1", "10"),
+			},
+			slashEraNotFound: true,
+			expect: []expectedSeq{
+				{model.SlashKindSlash, "validator3", "validator3", 100},
+				{model.SlashKindSlash, "nominator1", "validator3", 10},
+			},
+		},
+		{
+			description: "creates offline sequences for validator and its exposed nominators",
+			events: []*eventpb.Event{
+				{Index: 1, Section: "imOnline", Method: "SomeOffline", Data: []*eventpb.EventData{{Name: "Vec<IdentificationTuple>", Value: `[["validator1",{"total":30,"own":10,"others":[{"who":"nominator1","value":20}]}]]`}}},
+			},
+			expect: []expectedSeq{
+				{model.SlashKindOffline, "validator1", "validator1", 0},
+				{model.SlashKindOffline, "nominator1", "validator1", 0},
+			},
+		},
+		{
+			description: "creates single offline sequence of account exposed to several offline validators",
+			events: []*eventpb.Event{
+				{Index: 1, Section: "imOnline", Method: "SomeOffline", Data: []*eventpb.EventData{{Name: "Vec<IdentificationTuple>", Value: `[["validator1",{"others":[{"who":"nominator1"},{"who":"validator2"}]}],["validator2",{"others":[{"who":"nominator1"},{"who":"nominator2"}]}]]`}}},
+			},
+			expect: []expectedSeq{
+				{model.SlashKindOffline, "validator1", "validator1", 0},
+				{model.SlashKindOffline, "validator2", "validator2", 0},
+				{model.SlashKindOffline, "nominator1", "validator1", 0},
+				{model.SlashKindOffline, "nominator2", "validator2", 0},
+			},
+		},
+		{
+			description: "creates offence sequence",
+			events: []*eventpb.Event{
+				{Index: 1, Section: "offences", Method: "Offence", Data: []*eventpb.EventData{{Name: "Kind", Value: "0x696d2d6f6e6c696e653a6f66666c696e"}, {Name: "OpaqueTimeSlot", Value: "0x01"}}},
+			},
+			expect: []expectedSeq{
+				{model.SlashKindOffence, "", "", 0},
+			},
+		},
+		{
+			description: "returns error when slash event has no account",
+			events:      []*eventpb.Event{{Index: 1, Section: "staking", Method: "Slash", Data: []*eventpb.EventData{{Name: "Balance", Value: "1"}}}},
+			expectErr:   ErrSlashSequenceNotValid,
+		},
+		{
+			description: "ignores other events",
+			events:      []*eventpb.Event{{Index: 1, Section: "staking", Method: "Reward"}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stakingClientMock := mock_client.NewMockStakingClient(ctrl)
+			syncablesDbMock := mock.NewMockSyncables(ctrl)
+
+			hasSlashEvents := false
+			for _, event := range tt.events {
+				hasSlashEvents = hasSlashEvents || isSlashEvent(event)
+			}
+			if hasSlashEvents && tt.slashEraNotFound {
+				syncablesDbMock.EXPECT().FindLastInEra(int64(154)).Return(nil, store.ErrNotFound).Times(1)
+			} else if hasSlashEvents {
+				syncablesDbMock.EXPECT().FindLastInEra(int64(154)).Return(lastSyncableInSlashEra, nil).Times(1)
+				stakingClientMock.EXPECT().GetByHeight(lastSyncableInSlashEra.Height).Return(&stakingpb.GetByHeightResponse{Staking: slashEraStaking}, nil).Times(1)
+			}
+
+			task := NewSlashSeqCreatorTask(&cfg, stakingClientMock, syncablesDbMock)
+			pl := &payload{
+				CurrentHeight: syncable.Height,
+				Syncable:      syncable,
+				RawEvents:     tt.events,
+				RawStaking:    currentStaking,
+			}
+
+			if err := task.Run(context.Background(), pl); err != tt.expectErr {
+				t.Errorf("unexpected error, want: %v; got: %v", tt.expectErr, err)
+				return
+			}
+
+			if len(pl.SlashSequences) != len(tt.expect) {
+				t.Errorf("unexpected slash sequences count, want: %d; got: %d", len(tt.expect), len(pl.SlashSequences))
+				return
+			}
+
+			for i, expect := range tt.expect {
+				got := pl.SlashSequences[i]
+				if got.Kind != expect.kind || got.StashAccount != expect.stash || got.ValidatorStashAccount != expect.validator || !got.Amount.Equals(types.NewQuantityFromInt64(expect.amount)) {
+					t.Errorf("unexpected slash sequence, want: %+v; got: %+v", expect, got)
+				}
+				if got.Height != syncable.Height || got.Era != syncable.Era {
+					t.Errorf("unexpected slash sequence height or era: %+v", got)
+				}
+			}
+
+			if len(tt.expect) > 0 && tt.expect[0].kind == model.SlashKindOffence && pl.SlashSequences[0].OffenceKind != "im-online:offlin" {
+				t.Errorf("unexpected offence kind: %s", pl.SlashSequences[0].OffenceKind)
+			}
+		})
+	}
+}
+
+func TestAccountBalanceSeqCreatorTask_Run(t *testing.T) {
+	syncable := &model.Syncable{
+		Height: 20,
+		Time:   *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC)),
+	}
+
+	transfer := &eventpb.Event{Section: sectionBalances, Method: "Transfer", Data: []*eventpb.EventData{
+		{Name: accountKey, Value: "account1"},
+		{Name: accountKey, Value: "account2"},
+		{Name: "Balance", Value: "100"},
+	}}
+	bonded := &eventpb.Event{Section: sectionStaking, Method: "Bonded", Data: []*eventpb.EventData{
+		{Name: accountKey, Value: "account1"},
+		{Name: "Balance", Value: "10"},
+	}}
+	other := &eventpb.Event{Section: sectionIdentity, Method: "IdentitySet", Data: []*eventpb.EventData{
+		{Name: accountKey, Value: "account3"},
+	}}
+	staking := &stakingpb.Staking{Validators: []*stakingpb.Validator{
+		{StashAccount: "validator1", Stakers: []*stakingpb.Stake{{StashAccount: "account1"}, {StashAccount: "nominator1"}}},
+	}}
+
+	networkErr := status.Error(codes.Unavailable, "test")
+
+	cfg := *testCfg
+	cfg.AccountBalanceFetchWorkers = 2
+
+	tests := []struct {
+		description string
+		lastInEra   bool
+		failures    map[string]error
+		cancelled   bool
+		expect      []string
+		expectErr   bool
+	}{
+		{description: "creates snapshots of accounts touched by balances and staking events", expect: []string{"account1", "account2"}},
+		{description: "creates snapshots of stakers at the end of era", lastInEra: true, expect: []string{"account1", "account2", "validator1", "nominator1"}},
+		{description: "retries transient errors of single account", lastInEra: true, failures: map[string]error{"validator1": networkErr}, expect: []string{"account1", "account2", "validator1", "nominator1"}},
+		{description: "returns error which is not transient", failures: map[string]error{"account2": errors.New("test error")}, expect: []string{"account1", "account2"}, expectErr: true},
+		{description: "returns error when context is cancelled before all balances are fetched", cancelled: true, expect: []string{"account1", "account2"}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			clientMock := mock_client.NewMockAccountClient(ctrl)
+			for _, account := range tt.expect {
+				if err, ok := tt.failures[account]; ok {
+					clientMock.EXPECT().GetByHeight(account, syncable.Height).Return(nil, err).Times(1)
+					if err != networkErr {
+						continue
+					}
+				}
+				clientMock.EXPECT().GetByHeight(account, syncable.Height).Return(&accountpb.GetByHeightResponse{Account: &accountpb.Account{
+					Nonce: 3, ReferendumCount: 1, Free: "1000", Reserved: "10", MiscFrozen: "5", FeeFrozen: "5",
+				}}, nil).MaxTimes(1)
+			}
+
+			s := *syncable
+			s.LastInEra = tt.lastInEra
+			pl := &payload{
+				CurrentHeight: syncable.Height,
+				Syncable:      &s,
+				RawEvents:     []*eventpb.Event{transfer, bonded, other},
+				RawStaking:    staking,
+			}
+
+			task := NewAccountBalanceSeqCreatorTask(&cfg, clientMock)
+			task.policies = map[ErrorClass]retryPolicy{
+				ErrorClassNetwork: {maxAttempts: 3, initialBackoff: time.Millisecond, maxBackoff: time.Millisecond},
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.cancelled {
+				cancel()
+			}
+			defer cancel()
+
+			err := task.Run(ctx, pl)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("expected error")
+				}
+				if len(pl.AccountBalanceSequences) > 0 {
+					t.Errorf("unexpected balance sequences: %+v", pl.AccountBalanceSequences)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if len(pl.AccountBalanceSequences) != len(tt.expect) {
+				t.Errorf("unexpected balance sequences count, want: %d; got: %d", len(tt.expect), len(pl.AccountBalanceSequences))
+				return
+			}
+			for i, account := range tt.expect {
+				got := pl.AccountBalanceSequences[i]
+				if got.StashAccount != account || got.Height != syncable.Height || got.Nonce != 3 || got.ReferendumCount != 1 || got.Free.String() != "1000" || got.FeeFrozen.String() != "5" {
+					t.Errorf("unexpected balance sequence: %+v", got)
+				}
+			}
+		})
+	}
+}
@@ -16,18 +16,38 @@ var (
 	_ pipeline.Sink = (*sink)(nil)
 )
 
-func NewSink(databaseDb store.Database, syncablesDb store.Syncables, versionNumber int64) *sink {
+// NewSink creates sink which marks processed heights with given index version and records them for given targets
+func NewSink(databaseDb store.Database, syncablesDb store.Syncables, targetRangeDb store.TargetRanges, versionNumber int64, targetIds []int64) *sink {
 	return &sink{
 		databaseDb:    databaseDb,
 		syncablesDb:   syncablesDb,
+		targetRangeDb: targetRangeDb,
 		versionNumber: versionNumber,
+		targetIds:     targetIds,
+	}
+}
+
+// NewReindexSink creates sink which keeps index versions of processed syncables unchanged
+func NewReindexSink(databaseDb store.Database, syncablesDb store.Syncables, targetRangeDb store.TargetRanges, targetIds []int64) *sink {
+	return &sink{
+		databaseDb:           databaseDb,
+		syncablesDb:          syncablesDb,
+		targetRangeDb:        targetRangeDb,
+		targetIds:            targetIds,
+		preserveIndexVersion: true,
 	}
 }
 
 type sink struct {
-	databaseDb    store.Database
-	syncablesDb   store.Syncables
-	versionNumber int64
+	databaseDb           store.Database
+	syncablesDb          store.Syncables
+	targetRangeDb        store.TargetRanges
+	versionNumber        int64
+	targetIds            []int64
+	preserveIndexVersion bool
+
+	// reportCreator saves progress checkpoints of report when set
+	reportCreator *reportCreator
 
 	successCount int64
 }
@@ -45,19 +65,34 @@ func (s *sink) Consume(ctx context.Context, p pipeline.Payload) error {
 		return err
 	}
 
+	if err := s.targetRangeDb.MarkProcessed(s.targetIds, payload.CurrentHeight); err != nil {
+		return errors.Wrap(err, "failed marking targets processed in sink")
+	}
+
 	if err := s.addMetrics(payload.Syncable); err != nil {
 		return err
 	}
 
 	s.successCount += 1
 
+	if s.reportCreator != nil {
+		if err := s.reportCreator.checkpoint(payload.CurrentHeight, s.successCount); err != nil {
+			return errors.Wrap(err, "failed saving report checkpoint in sink")
+		}
+	}
+
 	logger.Info(fmt.Sprintf("processing completed [status=success] [height=%d]", payload.CurrentHeight))
 
 	return nil
 }
 
 func (s *sink) setProcessed(syncable *model.Syncable) error {
-	syncable.MarkProcessed(s.versionNumber)
+	versionNumber := s.versionNumber
+	if s.preserveIndexVersion {
+		versionNumber = syncable.IndexVersion
+	}
+
+	syncable.MarkProcessed(versionNumber)
 	if err := s.syncablesDb.SaveSyncable(syncable); err != nil {
 		return errors.Wrap(err, "failed saving syncable in sink")
 	}
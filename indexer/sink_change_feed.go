@@ -0,0 +1,229 @@
+package indexer
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	changeFeedOffsetFile  = "offset.json"
+	changeFeedFilePattern = "changes-%020d.ndjson.gz"
+)
+
+var (
+	_ PayloadSink = (*changeFeedSink)(nil)
+)
+
+// ChangeFeedRecord is single line of change feed with data created at given height
+type ChangeFeedRecord struct {
+	Offset  int64      `json:"offset"`
+	Height  int64      `json:"height"`
+	Time    types.Time `json:"time"`
+	Session int64      `json:"session"`
+	Era     int64      `json:"era"`
+
+	BlockSequence             *model.BlockSeq             `json:"block_sequence,omitempty"`
+	ValidatorSequences        []model.ValidatorSeq        `json:"validator_sequences,omitempty"`
+	ValidatorSessionSequences []model.ValidatorSessionSeq `json:"validator_session_sequences,omitempty"`
+	ValidatorEraSequences     []model.ValidatorEraSeq     `json:"validator_era_sequences,omitempty"`
+	EventSequences            []model.EventSeq            `json:"event_sequences,omitempty"`
+	AccountEraSequences       []model.AccountEraSeq       `json:"account_era_sequences,omitempty"`
+	TransactionSequences      []model.TransactionSeq      `json:"transaction_sequences,omitempty"`
+	RewardEraSequences        []model.RewardEraSeq        `json:"reward_era_sequences,omitempty"`
+	RewardsClaimed            []RewardsClaim              `json:"rewards_claimed,omitempty"`
+	SlashSequences            []model.SlashSeq            `json:"slash_sequences,omitempty"`
+	AccountBalanceSequences   []model.AccountBalanceSeq   `json:"account_balance_sequences,omitempty"`
+	IdentityChanges           []model.IdentityChange      `json:"identity_changes,omitempty"`
+	SystemEvents              []model.SystemEvent         `json:"system_events,omitempty"`
+	RewardDiscrepancies       []model.RewardDiscrepancy   `json:"reward_discrepancies,omitempty"`
+}
+
+// ChangeFeedOffset is position of last record written to change feed
+type ChangeFeedOffset struct {
+	Offset int64  `json:"offset"`
+	Height int64  `json:"height"`
+	File   string `json:"file"`
+}
+
+// NewChangeFeedSink creates sink which writes records of processed heights to gzipped NDJSON files in given directory.
+// File is rotated when its uncompressed size exceeds maxFileSize. Offsets continue from offset file of previous run.
+func NewChangeFeedSink(dir string, maxFileSize int64) (*changeFeedSink, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	s := &changeFeedSink{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+	}
+
+	offset, err := ReadChangeFeedOffset(dir)
+	if err != nil {
+		return nil, err
+	}
+	if offset != nil {
+		s.offset = *offset
+	}
+	return s, nil
+}
+
+// ReadChangeFeedOffset reads offset of last record written to change feed in given directory, nil if nothing was written yet
+func ReadChangeFeedOffset(dir string) (*ChangeFeedOffset, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, changeFeedOffsetFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var offset ChangeFeedOffset
+	if err := json.Unmarshal(data, &offset); err != nil {
+		return nil, errors.Wrap(err, "failed parsing change feed offset")
+	}
+	return &offset, nil
+}
+
+// changeFeedSink writes change feed. Record is written before height is marked as processed,
+// so height which failed afterwards can be written again and consumers should skip offsets they already read.
+type changeFeedSink struct {
+	dir         string
+	maxFileSize int64
+
+	offset ChangeFeedOffset
+
+	file        *os.File
+	writer      *gzip.Writer
+	fileName    string
+	fileWritten int64
+}
+
+func (s *changeFeedSink) Consume(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	record := s.newRecord(payload)
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed marshaling change feed record")
+	}
+	data = append(data, '\n')
+
+	if s.writer == nil {
+		if err := s.open(record.Offset); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.writer.Write(data); err != nil {
+		return errors.Wrap(err, "failed writing change feed record")
+	}
+	if err := s.writer.Flush(); err != nil {
+		return errors.Wrap(err, "failed flushing change feed record")
+	}
+	s.fileWritten += int64(len(data))
+
+	if err := s.saveOffset(ChangeFeedOffset{Offset: record.Offset, Height: record.Height, File: s.fileName}); err != nil {
+		return err
+	}
+
+	if s.maxFileSize > 0 && s.fileWritten >= s.maxFileSize {
+		return s.Close()
+	}
+	return nil
+}
+
+// Close finishes current file of change feed, next record is written to new file
+func (s *changeFeedSink) Close() error {
+	if s.writer == nil {
+		return nil
+	}
+
+	writer, file := s.writer, s.file
+	s.writer, s.file, s.fileName, s.fileWritten = nil, nil, "", 0
+
+	if err := writer.Close(); err != nil {
+		file.Close()
+		return errors.Wrap(err, "failed closing change feed writer")
+	}
+	return file.Close()
+}
+
+func (s *changeFeedSink) newRecord(payload *payload) *ChangeFeedRecord {
+	record := &ChangeFeedRecord{
+		Offset: s.offset.Offset + 1,
+		Height: payload.CurrentHeight,
+
+		BlockSequence:             payload.NewBlockSequence,
+		ValidatorSequences:        payload.ValidatorSequences,
+		ValidatorSessionSequences: payload.ValidatorSessionSequences,
+		ValidatorEraSequences:     payload.ValidatorEraSequences,
+		EventSequences:            payload.EventSequences,
+		AccountEraSequences:       payload.AccountEraSequences,
+		TransactionSequences:      payload.TransactionSequences,
+		RewardEraSequences:        payload.RewardEraSequences,
+		RewardsClaimed:            payload.RewardsClaimed,
+		SlashSequences:            payload.SlashSequences,
+		AccountBalanceSequences:   payload.AccountBalanceSequences,
+		IdentityChanges:           payload.IdentityChanges,
+		SystemEvents:              payload.SystemEvents,
+		RewardDiscrepancies:       payload.RewardDiscrepancies,
+	}
+
+	// Block sequence of height which was indexed before is updated instead of created
+	if record.BlockSequence == nil {
+		record.BlockSequence = payload.UpdatedBlockSequence
+	}
+
+	if payload.Syncable != nil {
+		record.Time = payload.Syncable.Time
+		record.Session = payload.Syncable.Session
+		record.Era = payload.Syncable.Era
+	}
+	return record
+}
+
+func (s *changeFeedSink) open(offset int64) error {
+	fileName := fmt.Sprintf(changeFeedFilePattern, offset)
+
+	file, err := os.OpenFile(filepath.Join(s.dir, fileName), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed opening change feed file")
+	}
+
+	logger.Info(fmt.Sprintf("opened change feed file [file=%s]", fileName))
+
+	s.file = file
+	s.writer = gzip.NewWriter(file)
+	s.fileName = fileName
+	return nil
+}
+
+// saveOffset replaces offset file atomically, so it always points to record which was written completely
+func (s *changeFeedSink) saveOffset(offset ChangeFeedOffset) error {
+	data, err := json.Marshal(offset)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := filepath.Join(s.dir, changeFeedOffsetFile+".tmp")
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		return errors.Wrap(err, "failed writing change feed offset")
+	}
+	if err := os.Rename(tmpPath, filepath.Join(s.dir, changeFeedOffsetFile)); err != nil {
+		return errors.Wrap(err, "failed saving change feed offset")
+	}
+
+	s.offset = offset
+	return nil
+}
@@ -0,0 +1,179 @@
+package indexer
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/figment-networks/polkadothub-indexer/model"
+)
+
+func TestChangeFeedSink_Consume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "change_feed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Every record exceeds max file size, so each one is written to separate file
+	s, err := NewChangeFeedSink(dir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, height := range []int64{10, 11} {
+		p := &payload{
+			CurrentHeight: height,
+			Syncable:      &model.Syncable{Height: height, Session: 2, Era: 1},
+			SystemEvents:  []model.SystemEvent{{Height: height, Actor: "actor", Kind: model.SystemEventJoinedSet}},
+		}
+		if err := s.Consume(context.Background(), p); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+	}
+	if err := s.Close(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	for i, fileName := range []string{"changes-00000000000000000001.ndjson.gz", "changes-00000000000000000002.ndjson.gz"} {
+		records := readChangeFeedFile(t, filepath.Join(dir, fileName))
+		if len(records) != 1 {
+			t.Errorf("unexpected number of records in %s: %d", fileName, len(records))
+			continue
+		}
+		if records[0].Offset != int64(i+1) || records[0].Height != int64(10+i) || len(records[0].SystemEvents) != 1 {
+			t.Errorf("unexpected record in %s: %+v", fileName, records[0])
+		}
+	}
+
+	offset, err := ReadChangeFeedOffset(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if offset == nil || offset.Offset != 2 || offset.Height != 11 {
+		t.Errorf("unexpected offset: %+v", offset)
+	}
+
+	t.Run("resumes from saved offset", func(t *testing.T) {
+		s, err := NewChangeFeedSink(dir, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := s.Consume(context.Background(), &payload{CurrentHeight: 12}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if err := s.Close(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		records := readChangeFeedFile(t, filepath.Join(dir, "changes-00000000000000000003.ndjson.gz"))
+		if len(records) != 1 || records[0].Offset != 3 || records[0].Height != 12 {
+			t.Errorf("unexpected records: %+v", records)
+		}
+	})
+}
+
+func TestChangeFeedSink_newRecord(t *testing.T) {
+	// Payload fields which are not written to change feed, data persisted by new tasks has to be either added
+	// to change feed record or listed here
+	excluded := map[string]bool{
+		"CurrentHeight":              true,
+		"HeightMeta":                 true,
+		"RawBlock":                   true,
+		"RawValidatorPerformance":    true,
+		"RawStaking":                 true,
+		"RawEvents":                  true,
+		"RawTransactions":            true,
+		"RawValidators":              true,
+		"Syncable":                   true,
+		"ParsedBlock":                true,
+		"ParsedValidators":           true,
+		"Identities":                 true,
+		"NewValidatorAggregates":     true,
+		"UpdatedValidatorAggregates": true,
+		"UpdatedBlockSequence":       true,
+	}
+	// Payload fields written to record fields with different name
+	renamed := map[string]string{
+		"NewBlockSequence": "BlockSequence",
+	}
+
+	s := &changeFeedSink{}
+
+	payloadType := reflect.TypeOf(payload{})
+	for i := 0; i < payloadType.NumField(); i++ {
+		field := payloadType.Field(i)
+		if excluded[field.Name] {
+			continue
+		}
+
+		recordFieldName := field.Name
+		if name, ok := renamed[field.Name]; ok {
+			recordFieldName = name
+		}
+
+		t.Run(field.Name, func(t *testing.T) {
+			pl := &payload{}
+			value := reflect.ValueOf(pl).Elem().Field(i)
+			switch field.Type.Kind() {
+			case reflect.Slice:
+				value.Set(reflect.MakeSlice(field.Type, 1, 1))
+			case reflect.Ptr:
+				value.Set(reflect.New(field.Type.Elem()))
+			default:
+				t.Fatalf("unsupported kind of payload field: %s", field.Type.Kind())
+			}
+
+			record := reflect.ValueOf(s.newRecord(pl)).Elem().FieldByName(recordFieldName)
+			if !record.IsValid() {
+				t.Errorf("payload field %s is not written to change feed record", field.Name)
+				return
+			}
+			if !reflect.DeepEqual(record.Interface(), value.Interface()) {
+				t.Errorf("unexpected value of record field %s, want: %v; got: %v", recordFieldName, value.Interface(), record.Interface())
+			}
+		})
+	}
+
+	t.Run("writes updated block sequence when there is no new one", func(t *testing.T) {
+		blockSeq := &model.BlockSeq{}
+
+		record := s.newRecord(&payload{UpdatedBlockSequence: blockSeq})
+		if record.BlockSequence != blockSeq {
+			t.Errorf("unexpected block sequence: %v", record.BlockSequence)
+		}
+	})
+}
+
+func readChangeFeedFile(t *testing.T, path string) []ChangeFeedRecord {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var records []ChangeFeedRecord
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		var record ChangeFeedRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
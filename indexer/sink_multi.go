@@ -0,0 +1,71 @@
+package indexer
+
+import (
+	"context"
+	"io"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/polkadothub-indexer/config"
+)
+
+var (
+	_ pipeline.Sink = (*multiSink)(nil)
+)
+
+// PayloadSink receives payloads of processed heights, ie. to publish them to downstream services
+type PayloadSink interface {
+	pipeline.Sink
+	io.Closer
+}
+
+// NewMultiSink creates sink which passes payload to given sinks in order
+func NewMultiSink(sinks ...pipeline.Sink) *multiSink {
+	return &multiSink{
+		sinks: sinks,
+	}
+}
+
+// multiSink fans payload out to several sinks. It stops at first sink which fails,
+// so height is not marked processed before it was consumed by all preceding sinks.
+type multiSink struct {
+	sinks []pipeline.Sink
+}
+
+func (s *multiSink) Consume(ctx context.Context, p pipeline.Payload) error {
+	for _, sink := range s.sinks {
+		if err := sink.Consume(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes all sinks which can be closed and returns first error
+func (s *multiSink) Close() error {
+	var closeErr error
+	for _, sink := range s.sinks {
+		closer, ok := sink.(io.Closer)
+		if !ok {
+			continue
+		}
+		if err := closer.Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+	return closeErr
+}
+
+// newPayloadSinks creates payload sinks enabled in config
+func newPayloadSinks(cfg *config.Config) ([]PayloadSink, error) {
+	var sinks []PayloadSink
+
+	if cfg.ChangeFeedDir != "" {
+		changeFeedSink, err := NewChangeFeedSink(cfg.ChangeFeedDir, cfg.ChangeFeedMaxFileSize)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, changeFeedSink)
+	}
+
+	return sinks, nil
+}
@@ -0,0 +1,73 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+)
+
+type testSink struct {
+	name     string
+	consumed *[]string
+	err      error
+	closed   bool
+}
+
+func (s *testSink) Consume(ctx context.Context, p pipeline.Payload) error {
+	*s.consumed = append(*s.consumed, s.name)
+	return s.err
+}
+
+func (s *testSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestMultiSink_Consume(t *testing.T) {
+	t.Run("passes payload to all sinks in order", func(t *testing.T) {
+		var consumed []string
+		first := &testSink{name: "first", consumed: &consumed}
+		second := &testSink{name: "second", consumed: &consumed}
+
+		s := NewMultiSink(first, second)
+		if err := s.Consume(context.Background(), &payload{}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		if len(consumed) != 2 || consumed[0] != "first" || consumed[1] != "second" {
+			t.Errorf("unexpected order of sinks: %v", consumed)
+		}
+	})
+
+	t.Run("stops at first sink which fails", func(t *testing.T) {
+		var consumed []string
+		testErr := errors.New("test error")
+		first := &testSink{name: "first", consumed: &consumed, err: testErr}
+		second := &testSink{name: "second", consumed: &consumed}
+
+		s := NewMultiSink(first, second)
+		if err := s.Consume(context.Background(), &payload{}); err != testErr {
+			t.Errorf("unexpected error, want: %v; got: %v", testErr, err)
+		}
+
+		if len(consumed) != 1 {
+			t.Errorf("payload should not be passed to sinks after failed one: %v", consumed)
+		}
+	})
+}
+
+func TestMultiSink_Close(t *testing.T) {
+	var consumed []string
+	closer := &testSink{name: "closer", consumed: &consumed}
+
+	s := NewMultiSink(closer, &sink{})
+	if err := s.Close(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !closer.closed {
+		t.Errorf("sink should be closed")
+	}
+}
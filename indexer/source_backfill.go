@@ -16,7 +16,8 @@ var (
 	_ pipeline.Source = (*backfillSource)(nil)
 )
 
-func NewBackfillSource(cfg *config.Config, syncablesDb store.Syncables, client *client.Client, indexVersion int64, isLastInSession, isLastInEra bool) (*backfillSource, error) {
+// NewBackfillSource creates source which goes through heights from startHeight to endHeight
+func NewBackfillSource(cfg *config.Config, syncablesDb store.Syncables, client *client.Client, indexVersion int64, isLastInSession, isLastInEra bool, startHeight, endHeight int64) (*backfillSource, error) {
 	src := &backfillSource{
 		cfg:         cfg,
 		syncablesDb: syncablesDb,
@@ -25,7 +26,7 @@ func NewBackfillSource(cfg *config.Config, syncablesDb store.Syncables, client *
 		currentIndexVersion: indexVersion,
 	}
 
-	if err := src.init(isLastInSession, isLastInEra); err != nil {
+	if err := src.init(isLastInSession, isLastInEra, startHeight, endHeight); err != nil {
 		return nil, err
 	}
 
@@ -87,56 +88,27 @@ func (s *backfillSource) Len() int64 {
 	return s.endHeight - s.startHeight + 1
 }
 
-func (s *backfillSource) init(isLastInSession, isLastInEra bool) error {
+func (s *backfillSource) init(isLastInSession, isLastInEra bool, startHeight, endHeight int64) error {
+	s.currentHeight = startHeight
+	s.startHeight = startHeight
+	s.endHeight = endHeight
+
 	s.useWhiteList = isLastInSession || isLastInEra
 	if s.UseWhiteList() {
 		if err := s.setHeightsWhitelist(isLastInSession, isLastInEra); err != nil {
 			return err
 		}
 	}
-	if err := s.setStartHeight(); err != nil {
-		return err
-	}
-	if err := s.setEndHeight(); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (s *backfillSource) setStartHeight() error {
-	syncable, err := s.syncablesDb.FindFirstByDifferentIndexVersion(s.currentIndexVersion)
-	if err != nil {
-		if err == store.ErrNotFound {
-			return errors.New(fmt.Sprintf("nothing to backfill [currentIndexVersion=%d]", s.currentIndexVersion))
-		}
-		return err
-	}
-
-	s.currentHeight = syncable.Height
-	s.startHeight = syncable.Height
-	return nil
-}
-
-func (s *backfillSource) setEndHeight() error {
-	syncable, err := s.syncablesDb.FindMostRecentByDifferentIndexVersion(s.currentIndexVersion)
-	if err != nil {
-		if err == store.ErrNotFound {
-			return errors.New(fmt.Sprintf("nothing to backfill [currentIndexVersion=%d]", s.currentIndexVersion))
-		}
-		return err
-	}
-
-	s.endHeight = syncable.Height
 	return nil
 }
 
 func (s *backfillSource) setHeightsWhitelist(isLastInSession, isLastInEra bool) error {
-	syncables, err := s.syncablesDb.FindAllByLastInSessionOrEra(s.currentIndexVersion, isLastInSession, isLastInEra)
+	syncables, err := s.syncablesDb.FindAllByLastInSessionOrEra(s.startHeight, s.endHeight, isLastInSession, isLastInEra)
 	if err != nil {
 		return err
 	}
 	if len(syncables) == 0 {
-		return errors.New(fmt.Sprintf("no heights for whitelist to backfill [currentIndexVersion=%d]", s.currentIndexVersion))
+		return errors.New(fmt.Sprintf("no heights for whitelist to backfill [start=%d] [end=%d]", s.startHeight, s.endHeight))
 	}
 
 	s.generateMapForWhiteList(syncables)
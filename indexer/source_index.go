@@ -92,21 +92,11 @@ func (s *indexSource) setStartHeight() error {
 	if s.sourceCfg.StartHeight > 0 {
 		startH = s.sourceCfg.StartHeight
 	} else {
-		syncable, err := s.syncablesDb.FindMostRecent()
+		h, err := getResumeHeight(s.cfg, s.syncablesDb)
 		if err != nil {
-			if err != store.ErrNotFound {
-				return err
-			}
-			// No syncables found, get first block number from config
-			startH = s.cfg.FirstBlockHeight
-		} else {
-			// Reindex if last syncable failed
-			if syncable.ProcessedAt == nil {
-				startH = syncable.Height
-			} else {
-				startH = syncable.Height + 1
-			}
+			return err
 		}
+		startH = h
 	}
 
 	s.currentHeight = startH
@@ -115,6 +105,24 @@ func (s *indexSource) setStartHeight() error {
 	return nil
 }
 
+// getResumeHeight returns height from which indexing should continue based on most recent syncable
+func getResumeHeight(cfg *config.Config, syncablesDb store.Syncables) (int64, error) {
+	syncable, err := syncablesDb.FindMostRecent()
+	if err != nil {
+		if err != store.ErrNotFound {
+			return 0, err
+		}
+		// No syncables found, get first block number from config
+		return cfg.FirstBlockHeight, nil
+	}
+
+	// Reindex if last syncable failed
+	if syncable.ProcessedAt == nil {
+		return syncable.Height, nil
+	}
+	return syncable.Height + 1, nil
+}
+
 func (s *indexSource) setEndHeight() error {
 	syncableFromNode, err := s.client.Chain.GetHead()
 	if err != nil {
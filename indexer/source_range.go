@@ -0,0 +1,91 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+var (
+	_ pipeline.Source = (*rangeSource)(nil)
+)
+
+// NewRangeSource creates source which goes through already indexed heights from startHeight to endHeight
+func NewRangeSource(cfg *config.Config, syncablesDb store.Syncables, startHeight, endHeight int64) (*rangeSource, error) {
+	src := &rangeSource{
+		cfg:         cfg,
+		syncablesDb: syncablesDb,
+	}
+
+	if err := src.init(startHeight, endHeight); err != nil {
+		return nil, err
+	}
+
+	return src, nil
+}
+
+type rangeSource struct {
+	cfg         *config.Config
+	syncablesDb store.Syncables
+
+	currentHeight int64
+	startHeight   int64
+	endHeight     int64
+	err           error
+}
+
+func (s *rangeSource) Next(context.Context, pipeline.Payload) bool {
+	if s.err == nil && s.currentHeight < s.endHeight {
+		s.currentHeight = s.currentHeight + 1
+		return true
+	}
+	return false
+}
+
+func (s *rangeSource) Current() int64 {
+	return s.currentHeight
+}
+
+func (s *rangeSource) Err() error {
+	return s.err
+}
+
+func (s *rangeSource) Skip(stageName pipeline.StageName) bool {
+	return false
+}
+
+func (s *rangeSource) Len() int64 {
+	return s.endHeight - s.startHeight + 1
+}
+
+func (s *rangeSource) init(startHeight, endHeight int64) error {
+	if startHeight > endHeight {
+		return errors.New(fmt.Sprintf("invalid reindex range: start height %d is greater than end height %d", startHeight, endHeight))
+	}
+
+	if startHeight < s.cfg.FirstBlockHeight {
+		return errors.New(fmt.Sprintf("invalid reindex range: start height %d is lower than first block height %d", startHeight, s.cfg.FirstBlockHeight))
+	}
+
+	// Only already indexed heights can be reindexed
+	syncable, err := s.syncablesDb.FindMostRecent()
+	if err != nil {
+		if err == store.ErrNotFound {
+			return errors.New("invalid reindex range: nothing has been indexed yet")
+		}
+		return err
+	}
+
+	if endHeight > syncable.Height {
+		return errors.New(fmt.Sprintf("invalid reindex range: end height %d is greater than last indexed height %d", endHeight, syncable.Height))
+	}
+
+	s.currentHeight = startHeight
+	s.startHeight = startHeight
+	s.endHeight = endHeight
+	return nil
+}
@@ -0,0 +1,70 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/figment-networks/polkadothub-indexer/mock/store"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/golang/mock/gomock"
+)
+
+func TestRangeSource(t *testing.T) {
+	tests := []struct {
+		description  string
+		startHeight  int64
+		endHeight    int64
+		recentHeight int64
+		recentErr    error
+		expectErr    bool
+	}{
+		{"goes through indexed range", 10, 12, 20, nil, false},
+		{"goes through single height", 20, 20, 20, nil, false},
+		{"returns error when start height is greater than end height", 12, 10, 20, nil, true},
+		{"returns error when start height is lower than first block height", 0, 10, 20, nil, true},
+		{"returns error when end height is not indexed yet", 10, 21, 20, nil, true},
+		{"returns error when nothing is indexed", 10, 12, 0, store.ErrNotFound, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			syncableDbMock := mock.NewMockSyncables(ctrl)
+			if tt.startHeight <= tt.endHeight && tt.startHeight >= testCfg.FirstBlockHeight {
+				var syncable *model.Syncable
+				if tt.recentErr == nil {
+					syncable = &model.Syncable{Height: tt.recentHeight}
+				}
+				syncableDbMock.EXPECT().FindMostRecent().Return(syncable, tt.recentErr).Times(1)
+			}
+
+			source, err := NewRangeSource(testCfg, syncableDbMock, tt.startHeight, tt.endHeight)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("should return error")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			var heights []int64
+			for ok := true; ok; ok = source.Next(context.Background(), nil) {
+				heights = append(heights, source.Current())
+			}
+
+			if int64(len(heights)) != source.Len() {
+				t.Errorf("unexpected number of heights, want: %d; got: %d", source.Len(), len(heights))
+			}
+			if heights[0] != tt.startHeight || heights[len(heights)-1] != tt.endHeight {
+				t.Errorf("unexpected heights, want: %d-%d; got: %v", tt.startHeight, tt.endHeight, heights)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/metric"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+var (
+	_ pipeline.Source = (*streamSource)(nil)
+)
+
+type StreamSourceConfig struct {
+	StartHeight  int64
+	PollInterval time.Duration
+	MaxBackoff   time.Duration
+	OnNewHead    func(height int64)
+}
+
+// NewStreamSource creates source which follows chain head. It blocks until start height is available on chain.
+func NewStreamSource(ctx context.Context, cfg *config.Config, syncablesDb store.Syncables, client client.ChainClient, sourceCfg *StreamSourceConfig) (*streamSource, error) {
+	src := &streamSource{
+		cfg:         cfg,
+		syncablesDb: syncablesDb,
+		client:      client,
+
+		sourceCfg: sourceCfg,
+	}
+	if err := src.init(ctx); err != nil {
+		return nil, err
+	}
+	return src, nil
+}
+
+type streamSource struct {
+	cfg         *config.Config
+	syncablesDb store.Syncables
+	client      client.ChainClient
+
+	sourceCfg *StreamSourceConfig
+
+	currentHeight int64
+	startHeight   int64
+	headHeight    int64
+}
+
+// Next moves to next height as soon as it becomes available on chain.
+// It returns false only when context is done.
+func (s *streamSource) Next(ctx context.Context, p pipeline.Payload) bool {
+	if p != nil {
+		s.addLagMetrics(p.(*payload))
+	}
+
+	if !s.waitForHeight(ctx, s.currentHeight+1) {
+		return false
+	}
+
+	s.currentHeight = s.currentHeight + 1
+	return true
+}
+
+func (s *streamSource) Current() int64 {
+	return s.currentHeight
+}
+
+func (s *streamSource) Err() error {
+	return nil
+}
+
+func (s *streamSource) Skip(stageName pipeline.StageName) bool {
+	return false
+}
+
+func (s *streamSource) Len() int64 {
+	return s.currentHeight - s.startHeight + 1
+}
+
+func (s *streamSource) init(ctx context.Context) error {
+	startH := s.sourceCfg.StartHeight
+	if startH == 0 {
+		h, err := getResumeHeight(s.cfg, s.syncablesDb)
+		if err != nil {
+			return err
+		}
+		startH = h
+	}
+
+	s.currentHeight = startH
+	s.startHeight = startH
+
+	if !s.waitForHeight(ctx, startH) {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// waitForHeight polls chain head until given height is available.
+// Interval between polls doubles up to max backoff when proxy is behind or unavailable.
+func (s *streamSource) waitForHeight(ctx context.Context, height int64) bool {
+	interval := s.sourceCfg.PollInterval
+
+	for height > s.headHeight {
+		if err := s.updateHeadHeight(); err != nil {
+			logger.Info(fmt.Sprintf("could not get chain head [err=%+v] [retry_in=%s]", err, interval))
+		} else if height <= s.headHeight {
+			return true
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(interval):
+		}
+
+		interval = interval * 2
+		if interval > s.sourceCfg.MaxBackoff {
+			interval = s.sourceCfg.MaxBackoff
+		}
+	}
+	return ctx.Err() == nil
+}
+
+func (s *streamSource) updateHeadHeight() error {
+	head, err := s.client.GetHead()
+	if err != nil {
+		return err
+	}
+
+	s.headHeight = head.GetHeight()
+	metric.IndexerStreamHeadHeight.Set(float64(s.headHeight))
+
+	if s.sourceCfg.OnNewHead != nil {
+		s.sourceCfg.OnNewHead(s.headHeight)
+	}
+	return nil
+}
+
+func (s *streamSource) addLagMetrics(p *payload) {
+	metric.IndexerStreamHeightLag.Set(float64(s.headHeight - p.CurrentHeight))
+	metric.IndexerStreamTimeLag.Set(time.Since(p.HeightMeta.Time.Time).Seconds())
+}
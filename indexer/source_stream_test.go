@@ -0,0 +1,132 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockClient "github.com/figment-networks/polkadothub-indexer/mock/client"
+	mock "github.com/figment-networks/polkadothub-indexer/mock/store"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-proxy/grpc/chain/chainpb"
+	"github.com/golang/mock/gomock"
+)
+
+func TestStreamSource(t *testing.T) {
+	sourceCfg := &StreamSourceConfig{
+		PollInterval: time.Millisecond,
+		MaxBackoff:   5 * time.Millisecond,
+	}
+
+	t.Run("resumes from height after most recent processed syncable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		syncableDbMock := mock.NewMockSyncables(ctrl)
+		clientMock := mockClient.NewMockChainClient(ctrl)
+
+		syncableDbMock.EXPECT().FindMostRecent().Return(&model.Syncable{Height: 20, ProcessedAt: types.NewTimeFromTime(time.Now())}, nil).Times(1)
+		clientMock.EXPECT().GetHead().Return(&chainpb.GetHeadResponse{Height: 25}, nil).Times(1)
+
+		source, err := NewStreamSource(context.Background(), testCfg, syncableDbMock, clientMock, sourceCfg)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		if source.Current() != 21 {
+			t.Errorf("unexpected current height, want: %d; got: %d", 21, source.Current())
+		}
+	})
+
+	t.Run("starts from first block height when there are no syncables", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		syncableDbMock := mock.NewMockSyncables(ctrl)
+		clientMock := mockClient.NewMockChainClient(ctrl)
+
+		syncableDbMock.EXPECT().FindMostRecent().Return(nil, store.ErrNotFound).Times(1)
+		clientMock.EXPECT().GetHead().Return(&chainpb.GetHeadResponse{Height: 25}, nil).Times(1)
+
+		source, err := NewStreamSource(context.Background(), testCfg, syncableDbMock, clientMock, sourceCfg)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		if source.Current() != testCfg.FirstBlockHeight {
+			t.Errorf("unexpected current height, want: %d; got: %d", testCfg.FirstBlockHeight, source.Current())
+		}
+	})
+
+	t.Run("waits for new height when chain head is reached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		syncableDbMock := mock.NewMockSyncables(ctrl)
+		clientMock := mockClient.NewMockChainClient(ctrl)
+
+		gomock.InOrder(
+			clientMock.EXPECT().GetHead().Return(&chainpb.GetHeadResponse{Height: 10}, nil).Times(1),
+			clientMock.EXPECT().GetHead().Return(&chainpb.GetHeadResponse{Height: 10}, nil).Times(1),
+			clientMock.EXPECT().GetHead().Return(nil, errors.New("proxy unavailable")).Times(1),
+			clientMock.EXPECT().GetHead().Return(&chainpb.GetHeadResponse{Height: 12}, nil).Times(1),
+		)
+
+		source, err := NewStreamSource(context.Background(), testCfg, syncableDbMock, clientMock, &StreamSourceConfig{
+			StartHeight:  10,
+			PollInterval: sourceCfg.PollInterval,
+			MaxBackoff:   sourceCfg.MaxBackoff,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		for _, want := range []int64{11, 12} {
+			if !source.Next(context.Background(), nil) {
+				t.Errorf("Next() should return true")
+				return
+			}
+			if source.Current() != want {
+				t.Errorf("unexpected current height, want: %d; got: %d", want, source.Current())
+			}
+		}
+	})
+
+	t.Run("stops when context is done", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		syncableDbMock := mock.NewMockSyncables(ctrl)
+		clientMock := mockClient.NewMockChainClient(ctrl)
+
+		clientMock.EXPECT().GetHead().Return(&chainpb.GetHeadResponse{Height: 10}, nil).AnyTimes()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		source, err := NewStreamSource(ctx, testCfg, syncableDbMock, clientMock, &StreamSourceConfig{
+			StartHeight:  10,
+			PollInterval: sourceCfg.PollInterval,
+			MaxBackoff:   sourceCfg.MaxBackoff,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		cancel()
+
+		if source.Next(ctx, nil) {
+			t.Errorf("Next() should return false")
+		}
+		if source.Err() != nil {
+			t.Errorf("unexpected error: %v", source.Err())
+		}
+	})
+}
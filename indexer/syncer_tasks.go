@@ -46,6 +46,8 @@ func (t *mainSyncerTask) Run(ctx context.Context, p pipeline.Payload) error {
 				Height: payload.CurrentHeight,
 				Time:   payload.HeightMeta.Time,
 
+				Hash:          payload.HeightMeta.Hash,
+				ParentHash:    payload.HeightMeta.ParentHash,
 				ChainUID:      payload.HeightMeta.ChainUID,
 				SpecVersion:   payload.HeightMeta.SpecVersion,
 				Session:       payload.HeightMeta.Session,
@@ -59,6 +61,15 @@ func (t *mainSyncerTask) Run(ctx context.Context, p pipeline.Payload) error {
 		}
 	}
 
+	if payload.HeightMeta.Hash != "" {
+		syncable.Hash = payload.HeightMeta.Hash
+		syncable.ParentHash = payload.HeightMeta.ParentHash
+	}
+
+	if err := t.checkParent(syncable); err != nil {
+		return err
+	}
+
 	syncable.StartedAt = *types.NewTimeFromTime(time.Now())
 
 	report, ok := ctx.Value(CtxReport).(*model.Report)
@@ -69,3 +80,23 @@ func (t *mainSyncerTask) Run(ctx context.Context, p pipeline.Payload) error {
 	payload.Syncable = syncable
 	return nil
 }
+
+// checkParent makes sure that syncable builds on top of already indexed parent height
+func (t *mainSyncerTask) checkParent(syncable *model.Syncable) error {
+	parent, err := t.syncablesDb.FindByHeight(syncable.Height - 1)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	if !syncable.IsChildOf(*parent) {
+		return &ReorgError{
+			Height:             syncable.Height,
+			ExpectedParentHash: parent.Hash,
+			ActualParentHash:   syncable.ParentHash,
+		}
+	}
+	return nil
+}
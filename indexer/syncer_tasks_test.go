@@ -0,0 +1,83 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mock "github.com/figment-networks/polkadothub-indexer/mock/store"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/golang/mock/gomock"
+)
+
+func TestMainSyncerTask_Run(t *testing.T) {
+	tests := []struct {
+		description string
+		parent      *model.Syncable
+		parentErr   error
+		meta        HeightMeta
+		expectErr   bool
+		expectReorg bool
+	}{
+		{
+			description: "succeeds when parent is not indexed",
+			parentErr:   store.ErrNotFound,
+			meta:        HeightMeta{Hash: "hash20", ParentHash: "hash19"},
+		},
+		{
+			description: "succeeds when parent hash matches",
+			parent:      &model.Syncable{Height: 19, Hash: "hash19"},
+			meta:        HeightMeta{Hash: "hash20", ParentHash: "hash19"},
+		},
+		{
+			description: "succeeds when parent hash was not recorded",
+			parent:      &model.Syncable{Height: 19},
+			meta:        HeightMeta{Hash: "hash20", ParentHash: "hash19"},
+		},
+		{
+			description: "returns reorg error when parent hash does not match",
+			parent:      &model.Syncable{Height: 19, Hash: "fork19"},
+			meta:        HeightMeta{Hash: "hash20", ParentHash: "hash19"},
+			expectErr:   true,
+			expectReorg: true,
+		},
+		{
+			description: "returns error when finding parent fails",
+			parentErr:   errors.New("test error"),
+			meta:        HeightMeta{Hash: "hash20", ParentHash: "hash19"},
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dbMock := mock.NewMockSyncables(ctrl)
+			dbMock.EXPECT().FindByHeight(int64(20)).Return(nil, store.ErrNotFound).Times(1)
+			dbMock.EXPECT().FindByHeight(int64(19)).Return(tt.parent, tt.parentErr).Times(1)
+
+			pl := &payload{CurrentHeight: 20, HeightMeta: tt.meta}
+
+			task := NewMainSyncerTask(dbMock)
+			err := task.Run(context.Background(), pl)
+			if tt.expectErr != (err != nil) {
+				t.Errorf("unexpected error, want error: %v; got: %v", tt.expectErr, err)
+				return
+			}
+
+			var reorgErr *ReorgError
+			if tt.expectReorg != errors.As(err, &reorgErr) {
+				t.Errorf("unexpected reorg error, want reorg: %v; got: %v", tt.expectReorg, err)
+				return
+			}
+
+			if err == nil && pl.Syncable.Hash != tt.meta.Hash {
+				t.Errorf("unexpected syncable hash, want: %v; got: %v", tt.meta.Hash, pl.Syncable.Hash)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package indexer
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+// TargetCoverage describes ranges of heights processed by indexing target
+type TargetCoverage struct {
+	TargetID int64
+	Name     string
+	// Ranges are continuous ranges of processed heights
+	Ranges []model.TargetRange
+	// MissingRanges are ranges of heights processed by other targets but not by this one
+	MissingRanges []model.TargetRange
+}
+
+// IsComplete returns true when target processed all heights processed by any other target
+func (c *TargetCoverage) IsComplete() bool {
+	return len(c.MissingRanges) == 0
+}
+
+// GetTargetsCoverage returns coverage of all versioned targets
+func GetTargetsCoverage(cfg *config.Config, targetRangeDb store.TargetRanges) ([]TargetCoverage, error) {
+	configParser, err := NewConfigParser(cfg.IndexerConfigFile)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := targetCoverageChecker{configParser: configParser, targetRangeDb: targetRangeDb}
+	return checker.getCoverage()
+}
+
+// targetCoverageChecker checks which heights were processed by which targets
+type targetCoverageChecker struct {
+	configParser  ConfigParser
+	targetRangeDb store.TargetRanges
+}
+
+// seed creates ranges for targets which have none from index versions of processed syncables.
+// It lets databases indexed before ranges were tracked keep their progress.
+func (c *targetCoverageChecker) seed() error {
+	ranges, err := c.targetRangeDb.FindAll()
+	if err != nil {
+		return err
+	}
+
+	seeded := make(map[int64]bool)
+	for _, r := range ranges {
+		seeded[r.TargetID] = true
+	}
+
+	for _, targetId := range c.configParser.GetAllVersionedTargetIds() {
+		if seeded[targetId] {
+			continue
+		}
+		if err := c.targetRangeDb.CreateFromSyncables(targetId, c.configParser.GetTargetVersionId(targetId)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *targetCoverageChecker) getCoverage() ([]TargetCoverage, error) {
+	ranges, err := c.targetRangeDb.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	rangesByTarget := make(map[int64][]model.TargetRange)
+	var startHeight, endHeight int64
+	for i, r := range ranges {
+		rangesByTarget[r.TargetID] = append(rangesByTarget[r.TargetID], r)
+
+		if i == 0 || r.StartHeight < startHeight {
+			startHeight = r.StartHeight
+		}
+		if i == 0 || r.EndHeight > endHeight {
+			endHeight = r.EndHeight
+		}
+	}
+
+	var coverage []TargetCoverage
+	for _, targetId := range c.configParser.GetAllVersionedTargetIds() {
+		targetCoverage := TargetCoverage{
+			TargetID: targetId,
+			Name:     c.configParser.GetTargetName(targetId),
+			Ranges:   rangesByTarget[targetId],
+		}
+		if len(ranges) > 0 {
+			targetCoverage.MissingRanges = getMissingRanges(targetId, targetCoverage.Ranges, startHeight, endHeight)
+		}
+		coverage = append(coverage, targetCoverage)
+	}
+	return coverage, nil
+}
+
+// getMissingRanges returns gaps between sorted ranges within startHeight and endHeight
+func getMissingRanges(targetId int64, ranges []model.TargetRange, startHeight, endHeight int64) []model.TargetRange {
+	var missing []model.TargetRange
+
+	next := startHeight
+	for _, r := range ranges {
+		if r.StartHeight > next {
+			missing = append(missing, model.TargetRange{TargetID: targetId, StartHeight: next, EndHeight: r.StartHeight - 1})
+		}
+		if r.EndHeight+1 > next {
+			next = r.EndHeight + 1
+		}
+	}
+
+	if next <= endHeight {
+		missing = append(missing, model.TargetRange{TargetID: targetId, StartHeight: next, EndHeight: endHeight})
+	}
+	return missing
+}
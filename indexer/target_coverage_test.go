@@ -0,0 +1,172 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	mockIndexer "github.com/figment-networks/polkadothub-indexer/mock/indexer"
+	mock "github.com/figment-networks/polkadothub-indexer/mock/store"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/golang/mock/gomock"
+)
+
+func TestTargetCoverageChecker_getCoverage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	configParserMock := mockIndexer.NewMockConfigParser(ctrl)
+	targetRangeDbMock := mock.NewMockTargetRanges(ctrl)
+
+	configParserMock.EXPECT().GetAllVersionedTargetIds().Return([]int64{1, 2, 3}).Times(1)
+	configParserMock.EXPECT().GetTargetName(gomock.Any()).Return("target").Times(3)
+	targetRangeDbMock.EXPECT().FindAll().Return([]model.TargetRange{
+		{TargetID: 1, StartHeight: 1, EndHeight: 100},
+		{TargetID: 2, StartHeight: 1, EndHeight: 20},
+		{TargetID: 2, StartHeight: 51, EndHeight: 90},
+	}, nil).Times(1)
+
+	checker := targetCoverageChecker{configParser: configParserMock, targetRangeDb: targetRangeDbMock}
+
+	coverage, err := checker.getCoverage()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	expectMissing := map[int64][]model.TargetRange{
+		1: nil,
+		2: {{TargetID: 2, StartHeight: 21, EndHeight: 50}, {TargetID: 2, StartHeight: 91, EndHeight: 100}},
+		3: {{TargetID: 3, StartHeight: 1, EndHeight: 100}},
+	}
+
+	if len(coverage) != len(expectMissing) {
+		t.Errorf("unexpected coverage length, want: %d; got: %d", len(expectMissing), len(coverage))
+		return
+	}
+
+	for _, c := range coverage {
+		if !reflect.DeepEqual(c.MissingRanges, expectMissing[c.TargetID]) {
+			t.Errorf("unexpected missing ranges for target %d, want: %+v; got: %+v", c.TargetID, expectMissing[c.TargetID], c.MissingRanges)
+		}
+		if c.IsComplete() != (c.TargetID == 1) {
+			t.Errorf("unexpected completeness of target %d: %t", c.TargetID, c.IsComplete())
+		}
+	}
+}
+
+func TestTargetCoverageChecker_seed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	configParserMock := mockIndexer.NewMockConfigParser(ctrl)
+	targetRangeDbMock := mock.NewMockTargetRanges(ctrl)
+
+	targetRangeDbMock.EXPECT().FindAll().Return([]model.TargetRange{{TargetID: 1, StartHeight: 1, EndHeight: 10}}, nil).Times(1)
+	configParserMock.EXPECT().GetAllVersionedTargetIds().Return([]int64{1, 2}).Times(1)
+	configParserMock.EXPECT().GetTargetVersionId(int64(2)).Return(int64(3)).Times(1)
+	targetRangeDbMock.EXPECT().CreateFromSyncables(int64(2), int64(3)).Return(nil).Times(1)
+
+	checker := targetCoverageChecker{configParser: configParserMock, targetRangeDb: targetRangeDbMock}
+
+	if err := checker.seed(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestGetMissingRanges(t *testing.T) {
+	tests := []struct {
+		description string
+		ranges      []model.TargetRange
+		expect      []model.TargetRange
+	}{
+		{"no ranges", nil, []model.TargetRange{{StartHeight: 1, EndHeight: 10}}},
+		{"complete range", []model.TargetRange{{StartHeight: 1, EndHeight: 10}}, nil},
+		{"missing beginning", []model.TargetRange{{StartHeight: 5, EndHeight: 10}}, []model.TargetRange{{StartHeight: 1, EndHeight: 4}}},
+		{"missing end", []model.TargetRange{{StartHeight: 1, EndHeight: 5}}, []model.TargetRange{{StartHeight: 6, EndHeight: 10}}},
+		{"missing middle", []model.TargetRange{{StartHeight: 1, EndHeight: 3}, {StartHeight: 7, EndHeight: 10}}, []model.TargetRange{{StartHeight: 4, EndHeight: 6}}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			if got := getMissingRanges(0, tt.ranges, 1, 10); !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("unexpected missing ranges, want: %+v; got: %+v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestGetMissingHeightRange(t *testing.T) {
+	coverage := []TargetCoverage{
+		{TargetID: 1, MissingRanges: []model.TargetRange{{StartHeight: 10, EndHeight: 20}}},
+		{TargetID: 2, MissingRanges: []model.TargetRange{{StartHeight: 5, EndHeight: 8}, {StartHeight: 30, EndHeight: 40}}},
+		{TargetID: 3, MissingRanges: []model.TargetRange{{StartHeight: 1, EndHeight: 100}}},
+	}
+
+	startHeight, endHeight := getMissingHeightRange(coverage, []int64{1, 2})
+	if startHeight != 5 || endHeight != 40 {
+		t.Errorf("unexpected range, want: %d-%d; got: %d-%d", 5, 40, startHeight, endHeight)
+	}
+}
+
+func TestPipelineStatusChecker_getStatus(t *testing.T) {
+	t.Run("returns pristine status when there are no syncables", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		configParserMock := mockIndexer.NewMockConfigParser(ctrl)
+		syncableDbMock := mock.NewMockSyncables(ctrl)
+
+		syncableDbMock.EXPECT().FindSmallestIndexVersion().Return(nil, store.ErrNotFound).Times(1)
+		configParserMock.EXPECT().GetAllVersionedTargetIds().Return([]int64{1, 2}).Times(1)
+
+		checker := pipelineStatusChecker{
+			syncablesDb:         syncableDbMock,
+			currentIndexVersion: 1,
+			coverageChecker:     &targetCoverageChecker{configParser: configParserMock},
+		}
+
+		status, err := checker.getStatus()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if !status.isPristine || status.isUpToDate || !reflect.DeepEqual(status.missingTargetIds, []int64{1, 2}) {
+			t.Errorf("unexpected status: %+v", status)
+		}
+	})
+
+	t.Run("returns targets which are not complete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		configParserMock := mockIndexer.NewMockConfigParser(ctrl)
+		syncableDbMock := mock.NewMockSyncables(ctrl)
+		targetRangeDbMock := mock.NewMockTargetRanges(ctrl)
+
+		version := int64(2)
+		syncableDbMock.EXPECT().FindSmallestIndexVersion().Return(&version, nil).Times(1)
+		targetRangeDbMock.EXPECT().FindAll().Return([]model.TargetRange{
+			{TargetID: 1, StartHeight: 1, EndHeight: 100},
+			{TargetID: 2, StartHeight: 50, EndHeight: 100},
+		}, nil).Times(2)
+		configParserMock.EXPECT().GetAllVersionedTargetIds().Return([]int64{1, 2}).Times(2)
+		configParserMock.EXPECT().GetTargetName(gomock.Any()).Return("target").Times(2)
+
+		checker := pipelineStatusChecker{
+			syncablesDb:         syncableDbMock,
+			currentIndexVersion: 2,
+			coverageChecker:     &targetCoverageChecker{configParser: configParserMock, targetRangeDb: targetRangeDbMock},
+		}
+
+		status, err := checker.getStatus()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if status.isPristine || status.isUpToDate || !reflect.DeepEqual(status.missingTargetIds, []int64{2}) {
+			t.Errorf("unexpected status: %+v", status)
+		}
+	})
+}
@@ -24,6 +24,13 @@ var (
 		Help: "The total number of failures during indexing",
 	})
 
+	IndexerTotalReorgs = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "figment",
+		Subsystem: "indexer",
+		Name: "total_reorg",
+		Help: "The total number of chain reorganizations rolled back during indexing",
+	})
+
 	IndexerHeightDuration = prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: "figment",
 		Subsystem: "indexer",
@@ -41,6 +48,16 @@ var (
 		[]string{"task"},
 	)
 
+	IndexerTaskRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "figment",
+			Subsystem: "indexer",
+			Name: "task_retry",
+			Help: "The total number of indexing task retries",
+		},
+		[]string{"task", "class"},
+	)
+
 	IndexerUseCaseDuration = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "figment",
@@ -51,6 +68,27 @@ var (
 		[]string{"task"},
 	)
 
+	IndexerStreamHeadHeight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "figment",
+		Subsystem: "indexer",
+		Name: "stream_head_height",
+		Help: "The most recent chain head height seen by streaming indexer",
+	})
+
+	IndexerStreamHeightLag = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "figment",
+		Subsystem: "indexer",
+		Name: "stream_height_lag",
+		Help: "The number of heights between chain head and last height indexed by streaming indexer",
+	})
+
+	IndexerStreamTimeLag = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "figment",
+		Subsystem: "indexer",
+		Name: "stream_time_lag",
+		Help: "The number of seconds between now and time of last height indexed by streaming indexer",
+	})
+
 	IndexerDbSizeAfterHeight = prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: "figment",
 		Subsystem: "indexer",
@@ -87,10 +125,15 @@ func (m *IndexerMetric) init() *IndexerMetric {
 
 	prometheus.MustRegister(IndexerHeightSuccess)
 	prometheus.MustRegister(IndexerTotalErrors)
+	prometheus.MustRegister(IndexerTotalReorgs)
 	prometheus.MustRegister(IndexerHeightDuration)
 	prometheus.MustRegister(IndexerTaskDuration)
+	prometheus.MustRegister(IndexerTaskRetries)
 	prometheus.MustRegister(IndexerUseCaseDuration)
 	prometheus.MustRegister(IndexerDbSizeAfterHeight)
+	prometheus.MustRegister(IndexerStreamHeadHeight)
+	prometheus.MustRegister(IndexerStreamHeightLag)
+	prometheus.MustRegister(IndexerStreamTimeLag)
 
 	// Add Go module build info.
 	prometheus.MustRegister(prometheus.NewBuildInfoCollector())
@@ -0,0 +1,2 @@
+ALTER TABLE syncables DROP COLUMN parent_hash;
+ALTER TABLE syncables DROP COLUMN hash;
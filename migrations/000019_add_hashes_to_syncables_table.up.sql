@@ -0,0 +1,2 @@
+ALTER TABLE syncables ADD COLUMN hash TEXT NOT NULL DEFAULT '';
+ALTER TABLE syncables ADD COLUMN parent_hash TEXT NOT NULL DEFAULT '';
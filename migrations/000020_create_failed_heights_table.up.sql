@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS failed_heights
+(
+    id              BIGSERIAL                NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    height          DECIMAL(65, 0)           NOT NULL,
+    index_version   INT                      NOT NULL,
+    stage           TEXT                     NOT NULL,
+    task            TEXT                     NOT NULL,
+    error_class     TEXT                     NOT NULL,
+    error_msg       TEXT                     NOT NULL,
+    payload_summary JSONB,
+    attempts        INT                      NOT NULL,
+    resolved_at     TIMESTAMP WITH TIME ZONE,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX idx_failed_heights_height
+    ON failed_heights (height);
+CREATE index idx_failed_heights_resolved_at on failed_heights (resolved_at);
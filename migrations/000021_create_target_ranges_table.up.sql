@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS target_ranges
+(
+    id           BIGSERIAL                NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    target_id    INT                      NOT NULL,
+    start_height DECIMAL(65, 0)           NOT NULL,
+    end_height   DECIMAL(65, 0)           NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_target_ranges_target_id on target_ranges (target_id, start_height);
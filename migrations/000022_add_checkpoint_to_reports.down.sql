@@ -0,0 +1,4 @@
+ALTER TABLE reports DROP COLUMN checkpointed_at;
+ALTER TABLE reports DROP COLUMN estimated_completed_at;
+ALTER TABLE reports DROP COLUMN heights_per_second;
+ALTER TABLE reports DROP COLUMN last_height;
@@ -0,0 +1,4 @@
+ALTER TABLE reports ADD COLUMN last_height DECIMAL(65, 0);
+ALTER TABLE reports ADD COLUMN heights_per_second DOUBLE PRECISION;
+ALTER TABLE reports ADD COLUMN estimated_completed_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE reports ADD COLUMN checkpointed_at TIMESTAMP WITH TIME ZONE;
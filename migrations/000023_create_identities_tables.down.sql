@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS identity_changes;
+DROP TABLE IF EXISTS identities;
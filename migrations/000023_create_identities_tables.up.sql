@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS identities
+(
+    id            BIGSERIAL                NOT NULL,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    stash_account TEXT                     NOT NULL,
+    display_name  TEXT                     NOT NULL,
+    refreshed_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS identity_changes
+(
+    id                    BIGSERIAL                NOT NULL,
+    created_at            TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at            TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    height                DECIMAL(65, 0)           NOT NULL,
+    time                  TIMESTAMP WITH TIME ZONE NOT NULL,
+    stash_account         TEXT                     NOT NULL,
+    display_name          TEXT                     NOT NULL,
+    previous_display_name TEXT                     NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX idx_identities_stash_account
+    ON identities(stash_account);
+
+CREATE index idx_identity_changes_stash_account on identity_changes (stash_account, height);
+CREATE UNIQUE INDEX idx_identity_changes_height
+    ON identity_changes (height, stash_account);
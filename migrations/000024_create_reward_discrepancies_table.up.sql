@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS reward_discrepancies
+(
+    id                      BIGSERIAL                NOT NULL,
+    created_at              TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at              TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    height                  DECIMAL(65, 0)           NOT NULL,
+    time                    TIMESTAMP WITH TIME ZONE NOT NULL,
+    era                     DECIMAL(65, 0)           NOT NULL,
+    stash_account           TEXT                     NOT NULL,
+    validator_stash_account TEXT                     NOT NULL,
+    predicted_amount        DECIMAL(65, 0)           NOT NULL,
+    actual_amount           DECIMAL(65, 0)           NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX idx_reward_discrepancies_accounts
+    ON reward_discrepancies(era, stash_account, validator_stash_account);
+
+CREATE index idx_reward_discrepancies_validator_era on reward_discrepancies (validator_stash_account, era);
+CREATE index idx_reward_discrepancies_height on reward_discrepancies (height);
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS slash_sequences
+(
+    id                      BIGSERIAL                NOT NULL,
+
+    height                  DECIMAL(65, 0)           NOT NULL,
+    time                    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    era                     DECIMAL(65, 0)           NOT NULL,
+    event_index             DECIMAL(65, 0)           NOT NULL,
+    kind                    TEXT                     NOT NULL,
+    stash_account           TEXT                     NOT NULL,
+    validator_stash_account TEXT                     NOT NULL,
+    amount                  DECIMAL(65, 0)           NOT NULL,
+    offence_kind            TEXT                     NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX idx_slash_sequences_event_account
+    ON slash_sequences(height, event_index, stash_account);
+
+CREATE index idx_slash_sequences_stash_account on slash_sequences (stash_account, height);
+CREATE index idx_slash_sequences_validator_stash_account on slash_sequences (validator_stash_account, height);
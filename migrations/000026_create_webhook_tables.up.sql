@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions
+(
+    id                   BIGSERIAL                NOT NULL,
+    created_at           TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at           TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    actor                TEXT                     NOT NULL,
+    kinds                TEXT[]                   NOT NULL DEFAULT '{}',
+    url                  TEXT                     NOT NULL,
+    secret               TEXT                     NOT NULL,
+    last_height          DECIMAL(65, 0)           NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id               BIGSERIAL                NOT NULL,
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at       TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    subscription_id  BIGINT                   NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    system_event_id  BIGINT                   NOT NULL,
+    payload          JSONB                    NOT NULL,
+    status           TEXT                     NOT NULL,
+    attempts         INT                      NOT NULL DEFAULT 0,
+    next_attempt_at  TIMESTAMP WITH TIME ZONE,
+    last_status_code INT,
+    last_error       TEXT,
+    delivered_at     TIMESTAMP WITH TIME ZONE,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX idx_webhook_deliveries_subscription_event
+    ON webhook_deliveries(subscription_id, system_event_id);
+
+CREATE index idx_webhook_deliveries_status_next_attempt on webhook_deliveries (status, next_attempt_at);
@@ -0,0 +1,3 @@
+DROP index IF EXISTS idx_system_events_actor_height;
+DROP index IF EXISTS idx_system_events_kind_height;
+DROP index IF EXISTS idx_system_events_time;
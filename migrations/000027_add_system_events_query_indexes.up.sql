@@ -0,0 +1,3 @@
+CREATE index idx_system_events_actor_height on system_events (actor, height);
+CREATE index idx_system_events_kind_height on system_events (kind, height);
+CREATE index idx_system_events_time on system_events (time);
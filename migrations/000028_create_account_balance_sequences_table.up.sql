@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS account_balance_sequences
+(
+    id               BIGSERIAL                NOT NULL,
+
+    height           DECIMAL(65, 0)           NOT NULL,
+    time             TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    stash_account    TEXT                     NOT NULL,
+    nonce            BIGINT                   NOT NULL,
+    referendum_count BIGINT                   NOT NULL,
+    free             DECIMAL(65, 0)           NOT NULL,
+    reserved         DECIMAL(65, 0)           NOT NULL,
+    misc_frozen      DECIMAL(65, 0)           NOT NULL,
+    fee_frozen       DECIMAL(65, 0)           NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX idx_account_balance_sequences_stash_account_height
+    ON account_balance_sequences(stash_account, height);
+
+CREATE index idx_account_balance_sequences_height on account_balance_sequences (height);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_rewards_claimed_height;
+
+ALTER TABLE reward_era_sequences
+    DROP COLUMN IF EXISTS claimed_height;
@@ -0,0 +1,5 @@
+ALTER TABLE reward_era_sequences
+    ADD COLUMN IF NOT EXISTS claimed_height DECIMAL(65, 0);
+
+-- Indexes
+CREATE index idx_rewards_claimed_height on reward_era_sequences (claimed_height);
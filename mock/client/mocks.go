@@ -1,11 +1,14 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/figment-networks/polkadothub-indexer/client (interfaces: AccountClient)
+// Source: github.com/figment-networks/polkadothub-indexer/client (interfaces: AccountClient,BlockClient,ChainClient,StakingClient)
 
 // Package mock_client is a generated GoMock package.
 package mock_client
 
 import (
 	accountpb "github.com/figment-networks/polkadothub-proxy/grpc/account/accountpb"
+	blockpb "github.com/figment-networks/polkadothub-proxy/grpc/block/blockpb"
+	chainpb "github.com/figment-networks/polkadothub-proxy/grpc/chain/chainpb"
+	stakingpb "github.com/figment-networks/polkadothub-proxy/grpc/staking/stakingpb"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -62,3 +65,147 @@ func (mr *MockAccountClientMockRecorder) GetIdentity(arg0 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdentity", reflect.TypeOf((*MockAccountClient)(nil).GetIdentity), arg0)
 }
+
+// MockBlockClient is a mock of BlockClient interface
+type MockBlockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockClientMockRecorder
+}
+
+// MockBlockClientMockRecorder is the mock recorder for MockBlockClient
+type MockBlockClientMockRecorder struct {
+	mock *MockBlockClient
+}
+
+// NewMockBlockClient creates a new mock instance
+func NewMockBlockClient(ctrl *gomock.Controller) *MockBlockClient {
+	mock := &MockBlockClient{ctrl: ctrl}
+	mock.recorder = &MockBlockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBlockClient) EXPECT() *MockBlockClientMockRecorder {
+	return m.recorder
+}
+
+// GetByHeight mocks base method
+func (m *MockBlockClient) GetByHeight(arg0 int64) (*blockpb.GetByHeightResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHeight", arg0)
+	ret0, _ := ret[0].(*blockpb.GetByHeightResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHeight indicates an expected call of GetByHeight
+func (mr *MockBlockClientMockRecorder) GetByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHeight", reflect.TypeOf((*MockBlockClient)(nil).GetByHeight), arg0)
+}
+
+// MockChainClient is a mock of ChainClient interface
+type MockChainClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockChainClientMockRecorder
+}
+
+// MockChainClientMockRecorder is the mock recorder for MockChainClient
+type MockChainClientMockRecorder struct {
+	mock *MockChainClient
+}
+
+// NewMockChainClient creates a new mock instance
+func NewMockChainClient(ctrl *gomock.Controller) *MockChainClient {
+	mock := &MockChainClient{ctrl: ctrl}
+	mock.recorder = &MockChainClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockChainClient) EXPECT() *MockChainClientMockRecorder {
+	return m.recorder
+}
+
+// GeMetaByHeight mocks base method
+func (m *MockChainClient) GeMetaByHeight(arg0 int64) (*chainpb.GetMetaByHeightResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GeMetaByHeight", arg0)
+	ret0, _ := ret[0].(*chainpb.GetMetaByHeightResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GeMetaByHeight indicates an expected call of GeMetaByHeight
+func (mr *MockChainClientMockRecorder) GeMetaByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeMetaByHeight", reflect.TypeOf((*MockChainClient)(nil).GeMetaByHeight), arg0)
+}
+
+// GeStatus mocks base method
+func (m *MockChainClient) GeStatus() (*chainpb.GetStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GeStatus")
+	ret0, _ := ret[0].(*chainpb.GetStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GeStatus indicates an expected call of GeStatus
+func (mr *MockChainClientMockRecorder) GeStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeStatus", reflect.TypeOf((*MockChainClient)(nil).GeStatus))
+}
+
+// GetHead mocks base method
+func (m *MockChainClient) GetHead() (*chainpb.GetHeadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHead")
+	ret0, _ := ret[0].(*chainpb.GetHeadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHead indicates an expected call of GetHead
+func (mr *MockChainClientMockRecorder) GetHead() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHead", reflect.TypeOf((*MockChainClient)(nil).GetHead))
+}
+
+// MockStakingClient is a mock of StakingClient interface
+type MockStakingClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockStakingClientMockRecorder
+}
+
+// MockStakingClientMockRecorder is the mock recorder for MockStakingClient
+type MockStakingClientMockRecorder struct {
+	mock *MockStakingClient
+}
+
+// NewMockStakingClient creates a new mock instance
+func NewMockStakingClient(ctrl *gomock.Controller) *MockStakingClient {
+	mock := &MockStakingClient{ctrl: ctrl}
+	mock.recorder = &MockStakingClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStakingClient) EXPECT() *MockStakingClientMockRecorder {
+	return m.recorder
+}
+
+// GetByHeight mocks base method
+func (m *MockStakingClient) GetByHeight(arg0 int64) (*stakingpb.GetByHeightResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHeight", arg0)
+	ret0, _ := ret[0].(*stakingpb.GetByHeightResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHeight indicates an expected call of GetByHeight
+func (mr *MockStakingClientMockRecorder) GetByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHeight", reflect.TypeOf((*MockStakingClient)(nil).GetByHeight), arg0)
+}
@@ -1,11 +1,13 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/figment-networks/polkadothub-indexer/indexer (interfaces: ConfigParser,FetcherClient,RewardsCalculator)
+// Source: github.com/figment-networks/polkadothub-indexer/indexer (interfaces: ConfigParser,FetcherClient,IdentityResolver,RewardsCalculator)
 
 // Package mock_indexer is a generated GoMock package.
 package mock_indexer
 
 import (
 	pipeline "github.com/figment-networks/indexing-engine/pipeline"
+	model "github.com/figment-networks/polkadothub-indexer/model"
+	eventpb "github.com/figment-networks/polkadothub-proxy/grpc/event/eventpb"
 	heightpb "github.com/figment-networks/polkadothub-proxy/grpc/height/heightpb"
 	gomock "github.com/golang/mock/gomock"
 	big "math/big"
@@ -49,6 +51,20 @@ func (mr *MockConfigParserMockRecorder) GetAllAvailableTasks() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAvailableTasks", reflect.TypeOf((*MockConfigParser)(nil).GetAllAvailableTasks))
 }
 
+// GetAllVersionedTargetIds mocks base method
+func (m *MockConfigParser) GetAllVersionedTargetIds() []int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllVersionedTargetIds")
+	ret0, _ := ret[0].([]int64)
+	return ret0
+}
+
+// GetAllVersionedTargetIds indicates an expected call of GetAllVersionedTargetIds
+func (mr *MockConfigParserMockRecorder) GetAllVersionedTargetIds() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllVersionedTargetIds", reflect.TypeOf((*MockConfigParser)(nil).GetAllVersionedTargetIds))
+}
+
 // GetAllVersionedTasks mocks base method
 func (m *MockConfigParser) GetAllVersionedTasks() ([]pipeline.TaskName, error) {
 	m.ctrl.T.Helper()
@@ -92,6 +108,48 @@ func (mr *MockConfigParserMockRecorder) GetCurrentVersionId() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentVersionId", reflect.TypeOf((*MockConfigParser)(nil).GetCurrentVersionId))
 }
 
+// GetTargetIdsByTaskName mocks base method
+func (m *MockConfigParser) GetTargetIdsByTaskName(arg0 pipeline.TaskName) []int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTargetIdsByTaskName", arg0)
+	ret0, _ := ret[0].([]int64)
+	return ret0
+}
+
+// GetTargetIdsByTaskName indicates an expected call of GetTargetIdsByTaskName
+func (mr *MockConfigParserMockRecorder) GetTargetIdsByTaskName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargetIdsByTaskName", reflect.TypeOf((*MockConfigParser)(nil).GetTargetIdsByTaskName), arg0)
+}
+
+// GetTargetName mocks base method
+func (m *MockConfigParser) GetTargetName(arg0 int64) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTargetName", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetTargetName indicates an expected call of GetTargetName
+func (mr *MockConfigParserMockRecorder) GetTargetName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargetName", reflect.TypeOf((*MockConfigParser)(nil).GetTargetName), arg0)
+}
+
+// GetTargetVersionId mocks base method
+func (m *MockConfigParser) GetTargetVersionId(arg0 int64) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTargetVersionId", arg0)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetTargetVersionId indicates an expected call of GetTargetVersionId
+func (mr *MockConfigParserMockRecorder) GetTargetVersionId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargetVersionId", reflect.TypeOf((*MockConfigParser)(nil).GetTargetVersionId), arg0)
+}
+
 // GetTasksByTargetIds mocks base method
 func (m *MockConfigParser) GetTasksByTargetIds(arg0 []int64) ([]pipeline.TaskName, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +180,20 @@ func (mr *MockConfigParserMockRecorder) GetTasksByVersionIds(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksByVersionIds", reflect.TypeOf((*MockConfigParser)(nil).GetTasksByVersionIds), arg0)
 }
 
+// IsAnyTargetSequential mocks base method
+func (m *MockConfigParser) IsAnyTargetSequential(arg0 []int64) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAnyTargetSequential", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAnyTargetSequential indicates an expected call of IsAnyTargetSequential
+func (mr *MockConfigParserMockRecorder) IsAnyTargetSequential(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAnyTargetSequential", reflect.TypeOf((*MockConfigParser)(nil).IsAnyTargetSequential), arg0)
+}
+
 // IsAnyVersionSequential mocks base method
 func (m *MockConfigParser) IsAnyVersionSequential(arg0 []int64) bool {
 	m.ctrl.T.Helper()
@@ -202,6 +274,46 @@ func (mr *MockFetcherClientMockRecorder) GetAll(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockFetcherClient)(nil).GetAll), arg0)
 }
 
+// MockIdentityResolver is a mock of IdentityResolver interface
+type MockIdentityResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityResolverMockRecorder
+}
+
+// MockIdentityResolverMockRecorder is the mock recorder for MockIdentityResolver
+type MockIdentityResolverMockRecorder struct {
+	mock *MockIdentityResolver
+}
+
+// NewMockIdentityResolver creates a new mock instance
+func NewMockIdentityResolver(ctrl *gomock.Controller) *MockIdentityResolver {
+	mock := &MockIdentityResolver{ctrl: ctrl}
+	mock.recorder = &MockIdentityResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockIdentityResolver) EXPECT() *MockIdentityResolverMockRecorder {
+	return m.recorder
+}
+
+// GetDisplayNames mocks base method
+func (m *MockIdentityResolver) GetDisplayNames(arg0 *model.Syncable, arg1 []string, arg2 []*eventpb.Event) (map[string]string, []model.Identity, []model.IdentityChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDisplayNames", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].([]model.Identity)
+	ret2, _ := ret[2].([]model.IdentityChange)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetDisplayNames indicates an expected call of GetDisplayNames
+func (mr *MockIdentityResolverMockRecorder) GetDisplayNames(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisplayNames", reflect.TypeOf((*MockIdentityResolver)(nil).GetDisplayNames), arg0, arg1, arg2)
+}
+
 // MockRewardsCalculator is a mock of RewardsCalculator interface
 type MockRewardsCalculator struct {
 	ctrl     *gomock.Controller
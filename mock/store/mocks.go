@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/figment-networks/polkadothub-indexer/store (interfaces: AccountEraSeq,BlockSeq,BlockSummary,Database,EventSeq,Reports,Rewards,Syncables,SystemEvents,TransactionSeq,ValidatorAgg,ValidatorSeq,ValidatorEraSeq,ValidatorSessionSeq,ValidatorSummary)
+// Source: github.com/figment-networks/polkadothub-indexer/store (interfaces: AccountActivity,AccountBalanceSeq,AccountEraSeq,BlockSeq,BlockSummary,Database,EventSeq,FailedHeights,Identities,Reports,Rewards,Slashes,Syncables,SystemEvents,TargetRanges,TransactionSeq,ValidatorAgg,ValidatorSeq,ValidatorEraSeq,ValidatorSessionSeq,ValidatorSummary,WebhookDeliveries,WebhookSubscriptions,Webhooks)
 
 // Package mock_store is a generated GoMock package.
 package mock_store
@@ -13,6 +13,110 @@ import (
 	time "time"
 )
 
+// MockAccountActivity is a mock of AccountActivity interface
+type MockAccountActivity struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountActivityMockRecorder
+}
+
+// MockAccountActivityMockRecorder is the mock recorder for MockAccountActivity
+type MockAccountActivityMockRecorder struct {
+	mock *MockAccountActivity
+}
+
+// NewMockAccountActivity creates a new mock instance
+func NewMockAccountActivity(ctrl *gomock.Controller) *MockAccountActivity {
+	mock := &MockAccountActivity{ctrl: ctrl}
+	mock.recorder = &MockAccountActivityMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAccountActivity) EXPECT() *MockAccountActivityMockRecorder {
+	return m.recorder
+}
+
+// FindActivityByQuery mocks base method
+func (m *MockAccountActivity) FindActivityByQuery(arg0 store.AccountActivityQuery) ([]model.AccountActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActivityByQuery", arg0)
+	ret0, _ := ret[0].([]model.AccountActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActivityByQuery indicates an expected call of FindActivityByQuery
+func (mr *MockAccountActivityMockRecorder) FindActivityByQuery(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActivityByQuery", reflect.TypeOf((*MockAccountActivity)(nil).FindActivityByQuery), arg0)
+}
+
+// MockAccountBalanceSeq is a mock of AccountBalanceSeq interface
+type MockAccountBalanceSeq struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountBalanceSeqMockRecorder
+}
+
+// MockAccountBalanceSeqMockRecorder is the mock recorder for MockAccountBalanceSeq
+type MockAccountBalanceSeqMockRecorder struct {
+	mock *MockAccountBalanceSeq
+}
+
+// NewMockAccountBalanceSeq creates a new mock instance
+func NewMockAccountBalanceSeq(ctrl *gomock.Controller) *MockAccountBalanceSeq {
+	mock := &MockAccountBalanceSeq{ctrl: ctrl}
+	mock.recorder = &MockAccountBalanceSeqMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAccountBalanceSeq) EXPECT() *MockAccountBalanceSeqMockRecorder {
+	return m.recorder
+}
+
+// BulkUpsertBalanceSeqs mocks base method
+func (m *MockAccountBalanceSeq) BulkUpsertBalanceSeqs(arg0 []model.AccountBalanceSeq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpsertBalanceSeqs", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkUpsertBalanceSeqs indicates an expected call of BulkUpsertBalanceSeqs
+func (mr *MockAccountBalanceSeqMockRecorder) BulkUpsertBalanceSeqs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsertBalanceSeqs", reflect.TypeOf((*MockAccountBalanceSeq)(nil).BulkUpsertBalanceSeqs), arg0)
+}
+
+// DeleteBalanceSeqsAfterHeight mocks base method
+func (m *MockAccountBalanceSeq) DeleteBalanceSeqsAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBalanceSeqsAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBalanceSeqsAfterHeight indicates an expected call of DeleteBalanceSeqsAfterHeight
+func (mr *MockAccountBalanceSeqMockRecorder) DeleteBalanceSeqsAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBalanceSeqsAfterHeight", reflect.TypeOf((*MockAccountBalanceSeq)(nil).DeleteBalanceSeqsAfterHeight), arg0)
+}
+
+// FindLastBalanceSeqByStashAccount mocks base method
+func (m *MockAccountBalanceSeq) FindLastBalanceSeqByStashAccount(arg0 string, arg1 *int64) (*model.AccountBalanceSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastBalanceSeqByStashAccount", arg0, arg1)
+	ret0, _ := ret[0].(*model.AccountBalanceSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastBalanceSeqByStashAccount indicates an expected call of FindLastBalanceSeqByStashAccount
+func (mr *MockAccountBalanceSeqMockRecorder) FindLastBalanceSeqByStashAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastBalanceSeqByStashAccount", reflect.TypeOf((*MockAccountBalanceSeq)(nil).FindLastBalanceSeqByStashAccount), arg0, arg1)
+}
+
 // MockAccountEraSeq is a mock of AccountEraSeq interface
 type MockAccountEraSeq struct {
 	ctrl     *gomock.Controller
@@ -50,6 +154,20 @@ func (mr *MockAccountEraSeqMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockAccountEraSeq)(nil).BulkUpsert), arg0)
 }
 
+// DeleteAfterHeight mocks base method
+func (m *MockAccountEraSeq) DeleteAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAfterHeight indicates an expected call of DeleteAfterHeight
+func (mr *MockAccountEraSeqMockRecorder) DeleteAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAfterHeight", reflect.TypeOf((*MockAccountEraSeq)(nil).DeleteAfterHeight), arg0)
+}
+
 // FindByEra mocks base method
 func (m *MockAccountEraSeq) FindByEra(arg0 int64) ([]model.AccountEraSeq, error) {
 	m.ctrl.T.Helper()
@@ -147,6 +265,20 @@ func (mr *MockBlockSeqMockRecorder) DeleteSeqOlderThan(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeqOlderThan", reflect.TypeOf((*MockBlockSeq)(nil).DeleteSeqOlderThan), arg0, arg1)
 }
 
+// DeleteSeqsAfterHeight mocks base method
+func (m *MockBlockSeq) DeleteSeqsAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeqsAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeqsAfterHeight indicates an expected call of DeleteSeqsAfterHeight
+func (mr *MockBlockSeqMockRecorder) DeleteSeqsAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeqsAfterHeight", reflect.TypeOf((*MockBlockSeq)(nil).DeleteSeqsAfterHeight), arg0)
+}
+
 // FindMostRecentSeq mocks base method
 func (m *MockBlockSeq) FindMostRecentSeq() (*model.BlockSeq, error) {
 	m.ctrl.T.Helper()
@@ -399,6 +531,20 @@ func (mr *MockDatabaseMockRecorder) GetTotalSize() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalSize", reflect.TypeOf((*MockDatabase)(nil).GetTotalSize))
 }
 
+// RollbackAfterHeight mocks base method
+func (m *MockDatabase) RollbackAfterHeight(arg0 int64, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackAfterHeight", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackAfterHeight indicates an expected call of RollbackAfterHeight
+func (mr *MockDatabaseMockRecorder) RollbackAfterHeight(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackAfterHeight", reflect.TypeOf((*MockDatabase)(nil).RollbackAfterHeight), arg0, arg1)
+}
+
 // MockEventSeq is a mock of EventSeq interface
 type MockEventSeq struct {
 	ctrl     *gomock.Controller
@@ -436,6 +582,20 @@ func (mr *MockEventSeqMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockEventSeq)(nil).BulkUpsert), arg0)
 }
 
+// DeleteAfterHeight mocks base method
+func (m *MockEventSeq) DeleteAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAfterHeight indicates an expected call of DeleteAfterHeight
+func (mr *MockEventSeqMockRecorder) DeleteAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAfterHeight", reflect.TypeOf((*MockEventSeq)(nil).DeleteAfterHeight), arg0)
+}
+
 // FindBalanceDeposits mocks base method
 func (m *MockEventSeq) FindBalanceDeposits(arg0 string) ([]model.EventSeqWithTxHash, error) {
 	m.ctrl.T.Helper()
@@ -526,6 +686,240 @@ func (mr *MockEventSeqMockRecorder) FindWithdrawn(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithdrawn", reflect.TypeOf((*MockEventSeq)(nil).FindWithdrawn), arg0)
 }
 
+// MockFailedHeights is a mock of FailedHeights interface
+type MockFailedHeights struct {
+	ctrl     *gomock.Controller
+	recorder *MockFailedHeightsMockRecorder
+}
+
+// MockFailedHeightsMockRecorder is the mock recorder for MockFailedHeights
+type MockFailedHeightsMockRecorder struct {
+	mock *MockFailedHeights
+}
+
+// NewMockFailedHeights creates a new mock instance
+func NewMockFailedHeights(ctrl *gomock.Controller) *MockFailedHeights {
+	mock := &MockFailedHeights{ctrl: ctrl}
+	mock.recorder = &MockFailedHeightsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFailedHeights) EXPECT() *MockFailedHeightsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockFailedHeights) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockFailedHeightsMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFailedHeights)(nil).Create), arg0)
+}
+
+// DeleteAfterHeight mocks base method
+func (m *MockFailedHeights) DeleteAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAfterHeight indicates an expected call of DeleteAfterHeight
+func (mr *MockFailedHeightsMockRecorder) DeleteAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAfterHeight", reflect.TypeOf((*MockFailedHeights)(nil).DeleteAfterHeight), arg0)
+}
+
+// FindByHeight mocks base method
+func (m *MockFailedHeights) FindByHeight(arg0 int64) (*model.FailedHeight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeight", arg0)
+	ret0, _ := ret[0].(*model.FailedHeight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeight indicates an expected call of FindByHeight
+func (mr *MockFailedHeightsMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockFailedHeights)(nil).FindByHeight), arg0)
+}
+
+// FindUnresolved mocks base method
+func (m *MockFailedHeights) FindUnresolved() ([]model.FailedHeight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnresolved")
+	ret0, _ := ret[0].([]model.FailedHeight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnresolved indicates an expected call of FindUnresolved
+func (mr *MockFailedHeightsMockRecorder) FindUnresolved() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnresolved", reflect.TypeOf((*MockFailedHeights)(nil).FindUnresolved))
+}
+
+// Save mocks base method
+func (m *MockFailedHeights) Save(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockFailedHeightsMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockFailedHeights)(nil).Save), arg0)
+}
+
+// Update mocks base method
+func (m *MockFailedHeights) Update(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockFailedHeightsMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFailedHeights)(nil).Update), arg0)
+}
+
+// MockIdentities is a mock of Identities interface
+type MockIdentities struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentitiesMockRecorder
+}
+
+// MockIdentitiesMockRecorder is the mock recorder for MockIdentities
+type MockIdentitiesMockRecorder struct {
+	mock *MockIdentities
+}
+
+// NewMockIdentities creates a new mock instance
+func NewMockIdentities(ctrl *gomock.Controller) *MockIdentities {
+	mock := &MockIdentities{ctrl: ctrl}
+	mock.recorder = &MockIdentitiesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockIdentities) EXPECT() *MockIdentitiesMockRecorder {
+	return m.recorder
+}
+
+// BulkUpsert mocks base method
+func (m *MockIdentities) BulkUpsert(arg0 []model.Identity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpsert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkUpsert indicates an expected call of BulkUpsert
+func (mr *MockIdentitiesMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockIdentities)(nil).BulkUpsert), arg0)
+}
+
+// BulkUpsertChanges mocks base method
+func (m *MockIdentities) BulkUpsertChanges(arg0 []model.IdentityChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpsertChanges", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkUpsertChanges indicates an expected call of BulkUpsertChanges
+func (mr *MockIdentitiesMockRecorder) BulkUpsertChanges(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsertChanges", reflect.TypeOf((*MockIdentities)(nil).BulkUpsertChanges), arg0)
+}
+
+// DeleteChangesAfterHeight mocks base method
+func (m *MockIdentities) DeleteChangesAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangesAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangesAfterHeight indicates an expected call of DeleteChangesAfterHeight
+func (mr *MockIdentitiesMockRecorder) DeleteChangesAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangesAfterHeight", reflect.TypeOf((*MockIdentities)(nil).DeleteChangesAfterHeight), arg0)
+}
+
+// FindByStashAccounts mocks base method
+func (m *MockIdentities) FindByStashAccounts(arg0 []string) ([]model.Identity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByStashAccounts", arg0)
+	ret0, _ := ret[0].([]model.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByStashAccounts indicates an expected call of FindByStashAccounts
+func (mr *MockIdentitiesMockRecorder) FindByStashAccounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByStashAccounts", reflect.TypeOf((*MockIdentities)(nil).FindByStashAccounts), arg0)
+}
+
+// FindChangesByHeight mocks base method
+func (m *MockIdentities) FindChangesByHeight(arg0 int64) ([]model.IdentityChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChangesByHeight", arg0)
+	ret0, _ := ret[0].([]model.IdentityChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChangesByHeight indicates an expected call of FindChangesByHeight
+func (mr *MockIdentitiesMockRecorder) FindChangesByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChangesByHeight", reflect.TypeOf((*MockIdentities)(nil).FindChangesByHeight), arg0)
+}
+
+// FindChangesByStashAccount mocks base method
+func (m *MockIdentities) FindChangesByStashAccount(arg0 string) ([]model.IdentityChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChangesByStashAccount", arg0)
+	ret0, _ := ret[0].([]model.IdentityChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChangesByStashAccount indicates an expected call of FindChangesByStashAccount
+func (mr *MockIdentitiesMockRecorder) FindChangesByStashAccount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChangesByStashAccount", reflect.TypeOf((*MockIdentities)(nil).FindChangesByStashAccount), arg0)
+}
+
+// FindLastChangesBeforeHeight mocks base method
+func (m *MockIdentities) FindLastChangesBeforeHeight(arg0 []string, arg1 int64) ([]model.IdentityChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastChangesBeforeHeight", arg0, arg1)
+	ret0, _ := ret[0].([]model.IdentityChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastChangesBeforeHeight indicates an expected call of FindLastChangesBeforeHeight
+func (mr *MockIdentitiesMockRecorder) FindLastChangesBeforeHeight(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastChangesBeforeHeight", reflect.TypeOf((*MockIdentities)(nil).FindLastChangesBeforeHeight), arg0, arg1)
+}
+
 // MockReports is a mock of Reports interface
 type MockReports struct {
 	ctrl     *gomock.Controller
@@ -616,6 +1010,21 @@ func (mr *MockReportsMockRecorder) FindNotCompletedByKind(arg0 ...interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNotCompletedByKind", reflect.TypeOf((*MockReports)(nil).FindNotCompletedByKind), arg0...)
 }
 
+// FindRecent mocks base method
+func (m *MockReports) FindRecent(arg0 int64) ([]model.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRecent", arg0)
+	ret0, _ := ret[0].([]model.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRecent indicates an expected call of FindRecent
+func (mr *MockReportsMockRecorder) FindRecent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecent", reflect.TypeOf((*MockReports)(nil).FindRecent), arg0)
+}
+
 // Last mocks base method
 func (m *MockReports) Last() (*model.Report, error) {
 	m.ctrl.T.Helper()
@@ -696,6 +1105,64 @@ func (mr *MockRewardsMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockRewards)(nil).BulkUpsert), arg0)
 }
 
+// BulkUpsertDiscrepancies mocks base method
+func (m *MockRewards) BulkUpsertDiscrepancies(arg0 []model.RewardDiscrepancy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpsertDiscrepancies", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkUpsertDiscrepancies indicates an expected call of BulkUpsertDiscrepancies
+func (mr *MockRewardsMockRecorder) BulkUpsertDiscrepancies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsertDiscrepancies", reflect.TypeOf((*MockRewards)(nil).BulkUpsertDiscrepancies), arg0)
+}
+
+// DeleteAfterHeight mocks base method
+func (m *MockRewards) DeleteAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAfterHeight indicates an expected call of DeleteAfterHeight
+func (mr *MockRewardsMockRecorder) DeleteAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAfterHeight", reflect.TypeOf((*MockRewards)(nil).DeleteAfterHeight), arg0)
+}
+
+// FindByEraAndValidatorStash mocks base method
+func (m *MockRewards) FindByEraAndValidatorStash(arg0 int64, arg1 string) ([]model.RewardEraSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEraAndValidatorStash", arg0, arg1)
+	ret0, _ := ret[0].([]model.RewardEraSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEraAndValidatorStash indicates an expected call of FindByEraAndValidatorStash
+func (mr *MockRewardsMockRecorder) FindByEraAndValidatorStash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEraAndValidatorStash", reflect.TypeOf((*MockRewards)(nil).FindByEraAndValidatorStash), arg0, arg1)
+}
+
+// FindDiscrepancies mocks base method
+func (m *MockRewards) FindDiscrepancies(arg0 string, arg1, arg2 int64) ([]model.RewardDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDiscrepancies", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.RewardDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDiscrepancies indicates an expected call of FindDiscrepancies
+func (mr *MockRewardsMockRecorder) FindDiscrepancies(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDiscrepancies", reflect.TypeOf((*MockRewards)(nil).FindDiscrepancies), arg0, arg1, arg2)
+}
+
 // GetAll mocks base method
 func (m *MockRewards) GetAll(arg0 string, arg1, arg2 int64) ([]model.RewardEraSeq, error) {
 	m.ctrl.T.Helper()
@@ -726,18 +1193,129 @@ func (mr *MockRewardsMockRecorder) GetCount(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCount", reflect.TypeOf((*MockRewards)(nil).GetCount), arg0, arg1)
 }
 
+// GetSummaries mocks base method
+func (m *MockRewards) GetSummaries(arg0 string, arg1, arg2 int64, arg3 model.RewardGroupBy, arg4, arg5 int64) ([]model.RewardSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummaries", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]model.RewardSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSummaries indicates an expected call of GetSummaries
+func (mr *MockRewardsMockRecorder) GetSummaries(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummaries", reflect.TypeOf((*MockRewards)(nil).GetSummaries), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// GetSummariesTotal mocks base method
+func (m *MockRewards) GetSummariesTotal(arg0 string, arg1, arg2 int64, arg3 model.RewardGroupBy) (model.RewardSummary, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummariesTotal", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(model.RewardSummary)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSummariesTotal indicates an expected call of GetSummariesTotal
+func (mr *MockRewardsMockRecorder) GetSummariesTotal(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummariesTotal", reflect.TypeOf((*MockRewards)(nil).GetSummariesTotal), arg0, arg1, arg2, arg3)
+}
+
 // MarkAllClaimed mocks base method
-func (m *MockRewards) MarkAllClaimed(arg0 string, arg1 int64) error {
+func (m *MockRewards) MarkAllClaimed(arg0 string, arg1, arg2 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkAllClaimed", arg0, arg1)
+	ret := m.ctrl.Call(m, "MarkAllClaimed", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // MarkAllClaimed indicates an expected call of MarkAllClaimed
-func (mr *MockRewardsMockRecorder) MarkAllClaimed(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockRewardsMockRecorder) MarkAllClaimed(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllClaimed", reflect.TypeOf((*MockRewards)(nil).MarkAllClaimed), arg0, arg1, arg2)
+}
+
+// StreamAll mocks base method
+func (m *MockRewards) StreamAll(arg0 string, arg1, arg2 int64, arg3 func(model.RewardEraSeq) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamAll indicates an expected call of StreamAll
+func (mr *MockRewardsMockRecorder) StreamAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamAll", reflect.TypeOf((*MockRewards)(nil).StreamAll), arg0, arg1, arg2, arg3)
+}
+
+// MockSlashes is a mock of Slashes interface
+type MockSlashes struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlashesMockRecorder
+}
+
+// MockSlashesMockRecorder is the mock recorder for MockSlashes
+type MockSlashesMockRecorder struct {
+	mock *MockSlashes
+}
+
+// NewMockSlashes creates a new mock instance
+func NewMockSlashes(ctrl *gomock.Controller) *MockSlashes {
+	mock := &MockSlashes{ctrl: ctrl}
+	mock.recorder = &MockSlashesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSlashes) EXPECT() *MockSlashesMockRecorder {
+	return m.recorder
+}
+
+// BulkUpsert mocks base method
+func (m *MockSlashes) BulkUpsert(arg0 []model.SlashSeq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpsert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkUpsert indicates an expected call of BulkUpsert
+func (mr *MockSlashesMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockSlashes)(nil).BulkUpsert), arg0)
+}
+
+// DeleteAfterHeight mocks base method
+func (m *MockSlashes) DeleteAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAfterHeight indicates an expected call of DeleteAfterHeight
+func (mr *MockSlashesMockRecorder) DeleteAfterHeight(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllClaimed", reflect.TypeOf((*MockRewards)(nil).MarkAllClaimed), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAfterHeight", reflect.TypeOf((*MockSlashes)(nil).DeleteAfterHeight), arg0)
+}
+
+// FindByAddress mocks base method
+func (m *MockSlashes) FindByAddress(arg0 string, arg1 *int64) ([]model.SlashSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAddress", arg0, arg1)
+	ret0, _ := ret[0].([]model.SlashSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAddress indicates an expected call of FindByAddress
+func (mr *MockSlashesMockRecorder) FindByAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAddress", reflect.TypeOf((*MockSlashes)(nil).FindByAddress), arg0, arg1)
 }
 
 // MockSyncables is a mock of Syncables interface
@@ -777,19 +1355,33 @@ func (mr *MockSyncablesMockRecorder) CreateOrUpdate(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockSyncables)(nil).CreateOrUpdate), arg0)
 }
 
+// DeleteAfterHeight mocks base method
+func (m *MockSyncables) DeleteAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAfterHeight indicates an expected call of DeleteAfterHeight
+func (mr *MockSyncablesMockRecorder) DeleteAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAfterHeight", reflect.TypeOf((*MockSyncables)(nil).DeleteAfterHeight), arg0)
+}
+
 // FindAllByLastInSessionOrEra mocks base method
-func (m *MockSyncables) FindAllByLastInSessionOrEra(arg0 int64, arg1, arg2 bool) ([]model.Syncable, error) {
+func (m *MockSyncables) FindAllByLastInSessionOrEra(arg0, arg1 int64, arg2, arg3 bool) ([]model.Syncable, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAllByLastInSessionOrEra", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "FindAllByLastInSessionOrEra", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]model.Syncable)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAllByLastInSessionOrEra indicates an expected call of FindAllByLastInSessionOrEra
-func (mr *MockSyncablesMockRecorder) FindAllByLastInSessionOrEra(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockSyncablesMockRecorder) FindAllByLastInSessionOrEra(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByLastInSessionOrEra", reflect.TypeOf((*MockSyncables)(nil).FindAllByLastInSessionOrEra), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByLastInSessionOrEra", reflect.TypeOf((*MockSyncables)(nil).FindAllByLastInSessionOrEra), arg0, arg1, arg2, arg3)
 }
 
 // FindByHeight mocks base method
@@ -927,6 +1519,21 @@ func (mr *MockSyncablesMockRecorder) FindMostRecentByDifferentIndexVersion(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMostRecentByDifferentIndexVersion", reflect.TypeOf((*MockSyncables)(nil).FindMostRecentByDifferentIndexVersion), arg0)
 }
 
+// FindMostRecentProcessed mocks base method
+func (m *MockSyncables) FindMostRecentProcessed() (*model.Syncable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMostRecentProcessed")
+	ret0, _ := ret[0].(*model.Syncable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMostRecentProcessed indicates an expected call of FindMostRecentProcessed
+func (mr *MockSyncablesMockRecorder) FindMostRecentProcessed() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMostRecentProcessed", reflect.TypeOf((*MockSyncables)(nil).FindMostRecentProcessed))
+}
+
 // FindSmallestIndexVersion mocks base method
 func (m *MockSyncables) FindSmallestIndexVersion() (*int64, error) {
 	m.ctrl.T.Helper()
@@ -981,45 +1588,169 @@ type MockSystemEventsMockRecorder struct {
 	mock *MockSystemEvents
 }
 
-// NewMockSystemEvents creates a new mock instance
-func NewMockSystemEvents(ctrl *gomock.Controller) *MockSystemEvents {
-	mock := &MockSystemEvents{ctrl: ctrl}
-	mock.recorder = &MockSystemEventsMockRecorder{mock}
+// NewMockSystemEvents creates a new mock instance
+func NewMockSystemEvents(ctrl *gomock.Controller) *MockSystemEvents {
+	mock := &MockSystemEvents{ctrl: ctrl}
+	mock.recorder = &MockSystemEventsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSystemEvents) EXPECT() *MockSystemEventsMockRecorder {
+	return m.recorder
+}
+
+// BulkUpsert mocks base method
+func (m *MockSystemEvents) BulkUpsert(arg0 []model.SystemEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpsert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkUpsert indicates an expected call of BulkUpsert
+func (mr *MockSystemEventsMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockSystemEvents)(nil).BulkUpsert), arg0)
+}
+
+// DeleteAfterHeight mocks base method
+func (m *MockSystemEvents) DeleteAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAfterHeight indicates an expected call of DeleteAfterHeight
+func (mr *MockSystemEventsMockRecorder) DeleteAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAfterHeight", reflect.TypeOf((*MockSystemEvents)(nil).DeleteAfterHeight), arg0)
+}
+
+// FindByActor mocks base method
+func (m *MockSystemEvents) FindByActor(arg0 string, arg1 *model.SystemEventKind, arg2 *int64) ([]model.SystemEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByActor", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.SystemEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByActor indicates an expected call of FindByActor
+func (mr *MockSystemEventsMockRecorder) FindByActor(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByActor", reflect.TypeOf((*MockSystemEvents)(nil).FindByActor), arg0, arg1, arg2)
+}
+
+// FindByQuery mocks base method
+func (m *MockSystemEvents) FindByQuery(arg0 store.SystemEventQuery) ([]model.SystemEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByQuery", arg0)
+	ret0, _ := ret[0].([]model.SystemEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByQuery indicates an expected call of FindByQuery
+func (mr *MockSystemEventsMockRecorder) FindByQuery(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByQuery", reflect.TypeOf((*MockSystemEvents)(nil).FindByQuery), arg0)
+}
+
+// FindInHeightRange mocks base method
+func (m *MockSystemEvents) FindInHeightRange(arg0, arg1 int64, arg2 string, arg3 []string) ([]model.SystemEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInHeightRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]model.SystemEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInHeightRange indicates an expected call of FindInHeightRange
+func (mr *MockSystemEventsMockRecorder) FindInHeightRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInHeightRange", reflect.TypeOf((*MockSystemEvents)(nil).FindInHeightRange), arg0, arg1, arg2, arg3)
+}
+
+// MockTargetRanges is a mock of TargetRanges interface
+type MockTargetRanges struct {
+	ctrl     *gomock.Controller
+	recorder *MockTargetRangesMockRecorder
+}
+
+// MockTargetRangesMockRecorder is the mock recorder for MockTargetRanges
+type MockTargetRangesMockRecorder struct {
+	mock *MockTargetRanges
+}
+
+// NewMockTargetRanges creates a new mock instance
+func NewMockTargetRanges(ctrl *gomock.Controller) *MockTargetRanges {
+	mock := &MockTargetRanges{ctrl: ctrl}
+	mock.recorder = &MockTargetRangesMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockSystemEvents) EXPECT() *MockSystemEventsMockRecorder {
+func (m *MockTargetRanges) EXPECT() *MockTargetRangesMockRecorder {
 	return m.recorder
 }
 
-// BulkUpsert mocks base method
-func (m *MockSystemEvents) BulkUpsert(arg0 []model.SystemEvent) error {
+// CreateFromSyncables mocks base method
+func (m *MockTargetRanges) CreateFromSyncables(arg0, arg1 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BulkUpsert", arg0)
+	ret := m.ctrl.Call(m, "CreateFromSyncables", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// BulkUpsert indicates an expected call of BulkUpsert
-func (mr *MockSystemEventsMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Call {
+// CreateFromSyncables indicates an expected call of CreateFromSyncables
+func (mr *MockTargetRangesMockRecorder) CreateFromSyncables(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockSystemEvents)(nil).BulkUpsert), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFromSyncables", reflect.TypeOf((*MockTargetRanges)(nil).CreateFromSyncables), arg0, arg1)
 }
 
-// FindByActor mocks base method
-func (m *MockSystemEvents) FindByActor(arg0 string, arg1 *model.SystemEventKind, arg2 *int64) ([]model.SystemEvent, error) {
+// DeleteAfterHeight mocks base method
+func (m *MockTargetRanges) DeleteAfterHeight(arg0 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByActor", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]model.SystemEvent)
+	ret := m.ctrl.Call(m, "DeleteAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAfterHeight indicates an expected call of DeleteAfterHeight
+func (mr *MockTargetRangesMockRecorder) DeleteAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAfterHeight", reflect.TypeOf((*MockTargetRanges)(nil).DeleteAfterHeight), arg0)
+}
+
+// FindAll mocks base method
+func (m *MockTargetRanges) FindAll() ([]model.TargetRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll")
+	ret0, _ := ret[0].([]model.TargetRange)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByActor indicates an expected call of FindByActor
-func (mr *MockSystemEventsMockRecorder) FindByActor(arg0, arg1, arg2 interface{}) *gomock.Call {
+// FindAll indicates an expected call of FindAll
+func (mr *MockTargetRangesMockRecorder) FindAll() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByActor", reflect.TypeOf((*MockSystemEvents)(nil).FindByActor), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTargetRanges)(nil).FindAll))
+}
+
+// MarkProcessed mocks base method
+func (m *MockTargetRanges) MarkProcessed(arg0 []int64, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkProcessed", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkProcessed indicates an expected call of MarkProcessed
+func (mr *MockTargetRangesMockRecorder) MarkProcessed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkProcessed", reflect.TypeOf((*MockTargetRanges)(nil).MarkProcessed), arg0, arg1)
 }
 
 // MockTransactionSeq is a mock of TransactionSeq interface
@@ -1059,6 +1790,20 @@ func (mr *MockTransactionSeqMockRecorder) BulkUpsert(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockTransactionSeq)(nil).BulkUpsert), arg0)
 }
 
+// DeleteAfterHeight mocks base method
+func (m *MockTransactionSeq) DeleteAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAfterHeight indicates an expected call of DeleteAfterHeight
+func (mr *MockTransactionSeqMockRecorder) DeleteAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAfterHeight", reflect.TypeOf((*MockTransactionSeq)(nil).DeleteAfterHeight), arg0)
+}
+
 // MockValidatorAgg is a mock of ValidatorAgg interface
 type MockValidatorAgg struct {
 	ctrl     *gomock.Controller
@@ -1207,6 +1952,20 @@ func (mr *MockValidatorSeqMockRecorder) BulkUpsertSeqs(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsertSeqs", reflect.TypeOf((*MockValidatorSeq)(nil).BulkUpsertSeqs), arg0)
 }
 
+// DeleteSeqsAfterHeight mocks base method
+func (m *MockValidatorSeq) DeleteSeqsAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeqsAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeqsAfterHeight indicates an expected call of DeleteSeqsAfterHeight
+func (mr *MockValidatorSeqMockRecorder) DeleteSeqsAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeqsAfterHeight", reflect.TypeOf((*MockValidatorSeq)(nil).DeleteSeqsAfterHeight), arg0)
+}
+
 // DeleteSeqsOlderThan mocks base method
 func (m *MockValidatorSeq) DeleteSeqsOlderThan(arg0 time.Time) (*int64, error) {
 	m.ctrl.T.Helper()
@@ -1289,6 +2048,20 @@ func (mr *MockValidatorEraSeqMockRecorder) BulkUpsertEraSeqs(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsertEraSeqs", reflect.TypeOf((*MockValidatorEraSeq)(nil).BulkUpsertEraSeqs), arg0)
 }
 
+// DeleteEraSeqsAfterHeight mocks base method
+func (m *MockValidatorEraSeq) DeleteEraSeqsAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEraSeqsAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEraSeqsAfterHeight indicates an expected call of DeleteEraSeqsAfterHeight
+func (mr *MockValidatorEraSeqMockRecorder) DeleteEraSeqsAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEraSeqsAfterHeight", reflect.TypeOf((*MockValidatorEraSeq)(nil).DeleteEraSeqsAfterHeight), arg0)
+}
+
 // DeleteEraSeqsOlderThan mocks base method
 func (m *MockValidatorEraSeq) DeleteEraSeqsOlderThan(arg0 time.Time) (*int64, error) {
 	m.ctrl.T.Helper()
@@ -1431,6 +2204,20 @@ func (mr *MockValidatorSessionSeqMockRecorder) BulkUpsertSessionSeqs(arg0 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsertSessionSeqs", reflect.TypeOf((*MockValidatorSessionSeq)(nil).BulkUpsertSessionSeqs), arg0)
 }
 
+// DeleteSessionSeqsAfterHeight mocks base method
+func (m *MockValidatorSessionSeq) DeleteSessionSeqsAfterHeight(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionSeqsAfterHeight", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSessionSeqsAfterHeight indicates an expected call of DeleteSessionSeqsAfterHeight
+func (mr *MockValidatorSessionSeqMockRecorder) DeleteSessionSeqsAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionSeqsAfterHeight", reflect.TypeOf((*MockValidatorSessionSeq)(nil).DeleteSessionSeqsAfterHeight), arg0)
+}
+
 // DeleteSessionSeqsOlderThan mocks base method
 func (m *MockValidatorSessionSeq) DeleteSessionSeqsOlderThan(arg0 time.Time) (*int64, error) {
 	m.ctrl.T.Helper()
@@ -1476,6 +2263,21 @@ func (mr *MockValidatorSessionSeqMockRecorder) FindBySessionAndStashAccount(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySessionAndStashAccount", reflect.TypeOf((*MockValidatorSessionSeq)(nil).FindBySessionAndStashAccount), arg0, arg1)
 }
 
+// FindBySessionRange mocks base method
+func (m *MockValidatorSessionSeq) FindBySessionRange(arg0, arg1 int64) ([]model.ValidatorSessionSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySessionRange", arg0, arg1)
+	ret0, _ := ret[0].([]model.ValidatorSessionSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySessionRange indicates an expected call of FindBySessionRange
+func (mr *MockValidatorSessionSeqMockRecorder) FindBySessionRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySessionRange", reflect.TypeOf((*MockValidatorSessionSeq)(nil).FindBySessionRange), arg0, arg1)
+}
+
 // FindLastSessionSeqByStashAccount mocks base method
 func (m *MockValidatorSessionSeq) FindLastSessionSeqByStashAccount(arg0 string, arg1 int64) ([]model.ValidatorSessionSeq, error) {
 	m.ctrl.T.Helper()
@@ -1691,3 +2493,332 @@ func (mr *MockValidatorSummaryMockRecorder) SaveSummary(arg0 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSummary", reflect.TypeOf((*MockValidatorSummary)(nil).SaveSummary), arg0)
 }
+
+// MockWebhookDeliveries is a mock of WebhookDeliveries interface
+type MockWebhookDeliveries struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveriesMockRecorder
+}
+
+// MockWebhookDeliveriesMockRecorder is the mock recorder for MockWebhookDeliveries
+type MockWebhookDeliveriesMockRecorder struct {
+	mock *MockWebhookDeliveries
+}
+
+// NewMockWebhookDeliveries creates a new mock instance
+func NewMockWebhookDeliveries(ctrl *gomock.Controller) *MockWebhookDeliveries {
+	mock := &MockWebhookDeliveries{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookDeliveries) EXPECT() *MockWebhookDeliveriesMockRecorder {
+	return m.recorder
+}
+
+// CreateDelivery mocks base method
+func (m *MockWebhookDeliveries) CreateDelivery(arg0 *model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDelivery indicates an expected call of CreateDelivery
+func (mr *MockWebhookDeliveriesMockRecorder) CreateDelivery(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockWebhookDeliveries)(nil).CreateDelivery), arg0)
+}
+
+// FindDeliveriesBySubscription mocks base method
+func (m *MockWebhookDeliveries) FindDeliveriesBySubscription(arg0, arg1 int64) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeliveriesBySubscription", arg0, arg1)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeliveriesBySubscription indicates an expected call of FindDeliveriesBySubscription
+func (mr *MockWebhookDeliveriesMockRecorder) FindDeliveriesBySubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeliveriesBySubscription", reflect.TypeOf((*MockWebhookDeliveries)(nil).FindDeliveriesBySubscription), arg0, arg1)
+}
+
+// FindDueDeliveries mocks base method
+func (m *MockWebhookDeliveries) FindDueDeliveries(arg0 time.Time, arg1 int64) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDueDeliveries", arg0, arg1)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDueDeliveries indicates an expected call of FindDueDeliveries
+func (mr *MockWebhookDeliveriesMockRecorder) FindDueDeliveries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDueDeliveries", reflect.TypeOf((*MockWebhookDeliveries)(nil).FindDueDeliveries), arg0, arg1)
+}
+
+// SaveDelivery mocks base method
+func (m *MockWebhookDeliveries) SaveDelivery(arg0 *model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDelivery", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDelivery indicates an expected call of SaveDelivery
+func (mr *MockWebhookDeliveriesMockRecorder) SaveDelivery(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDelivery", reflect.TypeOf((*MockWebhookDeliveries)(nil).SaveDelivery), arg0)
+}
+
+// MockWebhookSubscriptions is a mock of WebhookSubscriptions interface
+type MockWebhookSubscriptions struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSubscriptionsMockRecorder
+}
+
+// MockWebhookSubscriptionsMockRecorder is the mock recorder for MockWebhookSubscriptions
+type MockWebhookSubscriptionsMockRecorder struct {
+	mock *MockWebhookSubscriptions
+}
+
+// NewMockWebhookSubscriptions creates a new mock instance
+func NewMockWebhookSubscriptions(ctrl *gomock.Controller) *MockWebhookSubscriptions {
+	mock := &MockWebhookSubscriptions{ctrl: ctrl}
+	mock.recorder = &MockWebhookSubscriptionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookSubscriptions) EXPECT() *MockWebhookSubscriptionsMockRecorder {
+	return m.recorder
+}
+
+// CreateSubscription mocks base method
+func (m *MockWebhookSubscriptions) CreateSubscription(arg0 *model.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription
+func (mr *MockWebhookSubscriptionsMockRecorder) CreateSubscription(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockWebhookSubscriptions)(nil).CreateSubscription), arg0)
+}
+
+// DeleteSubscription mocks base method
+func (m *MockWebhookSubscriptions) DeleteSubscription(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription
+func (mr *MockWebhookSubscriptionsMockRecorder) DeleteSubscription(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhookSubscriptions)(nil).DeleteSubscription), arg0)
+}
+
+// FindSubscriptionByID mocks base method
+func (m *MockWebhookSubscriptions) FindSubscriptionByID(arg0 int64) (*model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubscriptionByID", arg0)
+	ret0, _ := ret[0].(*model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubscriptionByID indicates an expected call of FindSubscriptionByID
+func (mr *MockWebhookSubscriptionsMockRecorder) FindSubscriptionByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubscriptionByID", reflect.TypeOf((*MockWebhookSubscriptions)(nil).FindSubscriptionByID), arg0)
+}
+
+// FindSubscriptions mocks base method
+func (m *MockWebhookSubscriptions) FindSubscriptions() ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubscriptions")
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubscriptions indicates an expected call of FindSubscriptions
+func (mr *MockWebhookSubscriptionsMockRecorder) FindSubscriptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubscriptions", reflect.TypeOf((*MockWebhookSubscriptions)(nil).FindSubscriptions))
+}
+
+// SaveSubscription mocks base method
+func (m *MockWebhookSubscriptions) SaveSubscription(arg0 *model.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSubscription", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSubscription indicates an expected call of SaveSubscription
+func (mr *MockWebhookSubscriptionsMockRecorder) SaveSubscription(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSubscription", reflect.TypeOf((*MockWebhookSubscriptions)(nil).SaveSubscription), arg0)
+}
+
+// MockWebhooks is a mock of Webhooks interface
+type MockWebhooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhooksMockRecorder
+}
+
+// MockWebhooksMockRecorder is the mock recorder for MockWebhooks
+type MockWebhooksMockRecorder struct {
+	mock *MockWebhooks
+}
+
+// NewMockWebhooks creates a new mock instance
+func NewMockWebhooks(ctrl *gomock.Controller) *MockWebhooks {
+	mock := &MockWebhooks{ctrl: ctrl}
+	mock.recorder = &MockWebhooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhooks) EXPECT() *MockWebhooksMockRecorder {
+	return m.recorder
+}
+
+// CreateDelivery mocks base method
+func (m *MockWebhooks) CreateDelivery(arg0 *model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDelivery indicates an expected call of CreateDelivery
+func (mr *MockWebhooksMockRecorder) CreateDelivery(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockWebhooks)(nil).CreateDelivery), arg0)
+}
+
+// CreateSubscription mocks base method
+func (m *MockWebhooks) CreateSubscription(arg0 *model.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription
+func (mr *MockWebhooksMockRecorder) CreateSubscription(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockWebhooks)(nil).CreateSubscription), arg0)
+}
+
+// DeleteSubscription mocks base method
+func (m *MockWebhooks) DeleteSubscription(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription
+func (mr *MockWebhooksMockRecorder) DeleteSubscription(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhooks)(nil).DeleteSubscription), arg0)
+}
+
+// FindDeliveriesBySubscription mocks base method
+func (m *MockWebhooks) FindDeliveriesBySubscription(arg0, arg1 int64) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeliveriesBySubscription", arg0, arg1)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeliveriesBySubscription indicates an expected call of FindDeliveriesBySubscription
+func (mr *MockWebhooksMockRecorder) FindDeliveriesBySubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeliveriesBySubscription", reflect.TypeOf((*MockWebhooks)(nil).FindDeliveriesBySubscription), arg0, arg1)
+}
+
+// FindDueDeliveries mocks base method
+func (m *MockWebhooks) FindDueDeliveries(arg0 time.Time, arg1 int64) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDueDeliveries", arg0, arg1)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDueDeliveries indicates an expected call of FindDueDeliveries
+func (mr *MockWebhooksMockRecorder) FindDueDeliveries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDueDeliveries", reflect.TypeOf((*MockWebhooks)(nil).FindDueDeliveries), arg0, arg1)
+}
+
+// FindSubscriptionByID mocks base method
+func (m *MockWebhooks) FindSubscriptionByID(arg0 int64) (*model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubscriptionByID", arg0)
+	ret0, _ := ret[0].(*model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubscriptionByID indicates an expected call of FindSubscriptionByID
+func (mr *MockWebhooksMockRecorder) FindSubscriptionByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubscriptionByID", reflect.TypeOf((*MockWebhooks)(nil).FindSubscriptionByID), arg0)
+}
+
+// FindSubscriptions mocks base method
+func (m *MockWebhooks) FindSubscriptions() ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubscriptions")
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubscriptions indicates an expected call of FindSubscriptions
+func (mr *MockWebhooksMockRecorder) FindSubscriptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubscriptions", reflect.TypeOf((*MockWebhooks)(nil).FindSubscriptions))
+}
+
+// SaveDelivery mocks base method
+func (m *MockWebhooks) SaveDelivery(arg0 *model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDelivery", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDelivery indicates an expected call of SaveDelivery
+func (mr *MockWebhooksMockRecorder) SaveDelivery(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDelivery", reflect.TypeOf((*MockWebhooks)(nil).SaveDelivery), arg0)
+}
+
+// SaveSubscription mocks base method
+func (m *MockWebhooks) SaveSubscription(arg0 *model.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSubscription", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSubscription indicates an expected call of SaveSubscription
+func (mr *MockWebhooksMockRecorder) SaveSubscription(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSubscription", reflect.TypeOf((*MockWebhooks)(nil).SaveSubscription), arg0)
+}
@@ -0,0 +1,44 @@
+package model
+
+import "github.com/figment-networks/polkadothub-indexer/types"
+
+const (
+	AccountActivityTransfer         AccountActivityKind = "transfer"
+	AccountActivityDeposit          AccountActivityKind = "deposit"
+	AccountActivityBonded           AccountActivityKind = "bonded"
+	AccountActivityUnbonded         AccountActivityKind = "unbonded"
+	AccountActivityWithdrawn        AccountActivityKind = "withdrawn"
+	AccountActivityReward           AccountActivityKind = "reward"
+	AccountActivityDelegationJoined AccountActivityKind = "delegation_joined"
+	AccountActivityDelegationLeft   AccountActivityKind = "delegation_left"
+	AccountActivitySystemEvent      AccountActivityKind = "system_event"
+)
+
+// AccountActivityKinds are all kinds of account activity
+var AccountActivityKinds = []AccountActivityKind{
+	AccountActivityTransfer,
+	AccountActivityDeposit,
+	AccountActivityBonded,
+	AccountActivityUnbonded,
+	AccountActivityWithdrawn,
+	AccountActivityReward,
+	AccountActivityDelegationJoined,
+	AccountActivityDelegationLeft,
+	AccountActivitySystemEvent,
+}
+
+type AccountActivityKind string
+
+func (k AccountActivityKind) String() string {
+	return string(k)
+}
+
+// AccountActivity is single entry of account timeline. ID is id of record in table of its kind
+type AccountActivity struct {
+	ID     int64               `json:"id"`
+	Height int64               `json:"height"`
+	Time   types.Time          `json:"time"`
+	Kind   AccountActivityKind `json:"kind"`
+	TxHash string              `json:"tx_hash"`
+	Data   types.Jsonb         `json:"data"`
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/types"
+)
+
+// AccountBalanceSeq is snapshot of account balances at height
+type AccountBalanceSeq struct {
+	ID types.ID `json:"id"`
+
+	*Sequence
+
+	StashAccount    string         `json:"stash_account"`
+	Nonce           int64          `json:"nonce"`
+	ReferendumCount int64          `json:"referendum_count"`
+	Free            types.Quantity `json:"free"`
+	Reserved        types.Quantity `json:"reserved"`
+	MiscFrozen      types.Quantity `json:"misc_frozen"`
+	FeeFrozen       types.Quantity `json:"fee_frozen"`
+}
+
+func (AccountBalanceSeq) TableName() string {
+	return "account_balance_sequences"
+}
+
+func (s *AccountBalanceSeq) Valid() bool {
+	return s.Sequence.Valid() &&
+		s.StashAccount != "" &&
+		s.Free.Valid() &&
+		s.Reserved.Valid() &&
+		s.MiscFrozen.Valid() &&
+		s.FeeFrozen.Valid()
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/types"
+)
+
+// FailedHeight is a height which could not be indexed
+type FailedHeight struct {
+	*Model
+
+	Height         int64       `json:"height"`
+	IndexVersion   int64       `json:"index_version"`
+	Stage          string      `json:"stage"`
+	Task           string      `json:"task"`
+	ErrorClass     string      `json:"error_class"`
+	ErrorMsg       string      `json:"error_msg"`
+	PayloadSummary types.Jsonb `json:"payload_summary"`
+	Attempts       int64       `json:"attempts"`
+	ResolvedAt     *types.Time `json:"resolved_at"`
+}
+
+// FailedHeightPayloadSummary is data format for payload summary of failed height
+type FailedHeightPayloadSummary struct {
+	Time              *types.Time `json:"time,omitempty"`
+	SpecVersion       string      `json:"spec_version,omitempty"`
+	Session           int64       `json:"session"`
+	Era               int64       `json:"era"`
+	BlockHash         string      `json:"block_hash,omitempty"`
+	EventsCount       int         `json:"events_count"`
+	TransactionsCount int         `json:"transactions_count"`
+	ValidatorsCount   int         `json:"validators_count"`
+}
+
+func (FailedHeight) TableName() string {
+	return "failed_heights"
+}
+
+func (f *FailedHeight) Valid() bool {
+	return f.Height >= 0
+}
+
+func (f *FailedHeight) Equal(m FailedHeight) bool {
+	return f.Height == m.Height
+}
+
+func (f *FailedHeight) Resolve() {
+	f.ResolvedAt = types.NewTimeFromTime(time.Now())
+}
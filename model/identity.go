@@ -0,0 +1,56 @@
+package model
+
+import (
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/types"
+)
+
+// Identity is cached on-chain identity of account
+type Identity struct {
+	*Model
+
+	StashAccount string     `json:"stash_account"`
+	DisplayName  string     `json:"display_name"`
+	RefreshedAt  types.Time `json:"refreshed_at"`
+}
+
+func (Identity) TableName() string {
+	return "identities"
+}
+
+func (i *Identity) Valid() bool {
+	return i.StashAccount != ""
+}
+
+func (i *Identity) Equal(m Identity) bool {
+	return i.StashAccount == m.StashAccount
+}
+
+// IsStale returns true when identity was refreshed longer than ttl ago
+func (i *Identity) IsStale(ttl time.Duration) bool {
+	return time.Since(i.RefreshedAt.Time) > ttl
+}
+
+// IdentityChange is change of display name of account found at height
+type IdentityChange struct {
+	*Model
+
+	Height              int64      `json:"height"`
+	Time                types.Time `json:"time"`
+	StashAccount        string     `json:"stash_account"`
+	DisplayName         string     `json:"display_name"`
+	PreviousDisplayName string     `json:"previous_display_name"`
+}
+
+func (IdentityChange) TableName() string {
+	return "identity_changes"
+}
+
+func (c *IdentityChange) Valid() bool {
+	return c.Height >= 0 && c.StashAccount != ""
+}
+
+func (c *IdentityChange) Equal(m IdentityChange) bool {
+	return c.Height == m.Height && c.StashAccount == m.StashAccount
+}
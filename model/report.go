@@ -9,6 +9,8 @@ const (
 	ReportKindIndex ReportKind = iota + 1
 	ReportKindParallelReindex
 	ReportKindSequentialReindex
+	ReportKindRollback
+	ReportKindRangeReindex
 )
 
 type Report struct {
@@ -23,6 +25,11 @@ type Report struct {
 	ErrorMsg     *string
 	Duration     time.Duration
 	CompletedAt  *types.Time
+
+	LastHeight           *int64
+	HeightsPerSecond     *float64
+	EstimatedCompletedAt *types.Time
+	CheckpointedAt       *types.Time
 }
 
 type ReportKind int
@@ -35,6 +42,10 @@ func (k ReportKind) String() string {
 		return "parallel_reindex"
 	case ReportKindSequentialReindex:
 		return "sequential_reindex"
+	case ReportKindRollback:
+		return "rollback"
+	case ReportKindRangeReindex:
+		return "range_reindex"
 	default:
 		return "unknown"
 	}
@@ -54,6 +65,27 @@ func (r *Report) Equal(m Report) bool {
 		r.Model.ID == m.Model.ID
 }
 
+// Checkpoint records progress of report and estimates when it will be completed
+func (r *Report) Checkpoint(lastHeight int64, successCount int64, heightsPerSecond float64) {
+	now := time.Now()
+
+	r.LastHeight = &lastHeight
+	r.SuccessCount = &successCount
+	r.HeightsPerSecond = &heightsPerSecond
+	r.CheckpointedAt = types.NewTimeFromTime(now)
+
+	r.EstimatedCompletedAt = nil
+	if heightsPerSecond > 0 && r.EndHeight >= lastHeight {
+		remaining := float64(r.EndHeight-lastHeight) / heightsPerSecond
+		r.EstimatedCompletedAt = types.NewTimeFromTime(now.Add(time.Duration(remaining * float64(time.Second))))
+	}
+}
+
+// IsCompleted returns true when report was completed
+func (r *Report) IsCompleted() bool {
+	return r.CompletedAt != nil
+}
+
 func (r *Report) Complete(successCount int64, errorCount int64, err error) {
 	completedAt := types.NewTimeFromTime(time.Now())
 
@@ -0,0 +1,20 @@
+package model
+
+import "github.com/figment-networks/polkadothub-indexer/types"
+
+// RewardDiscrepancy is difference between reward predicted for account and reward paid out on chain
+type RewardDiscrepancy struct {
+	*Model
+
+	Height                int64          `json:"height"`
+	Time                  types.Time     `json:"time"`
+	Era                   int64          `json:"era"`
+	StashAccount          string         `json:"stash_account"`
+	ValidatorStashAccount string         `json:"validator_stash_account"`
+	PredictedAmount       types.Quantity `json:"predicted_amount"`
+	ActualAmount          types.Quantity `json:"actual_amount"`
+}
+
+func (RewardDiscrepancy) TableName() string {
+	return "reward_discrepancies"
+}
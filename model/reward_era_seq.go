@@ -21,6 +21,8 @@ type RewardEraSeq struct {
 	Amount                string     `json:"amount"`
 	Kind                  RewardKind `json:"kind"`
 	Claimed               bool       `json:"claimed"`
+	// ClaimedHeight is height at which rewards were claimed, it's empty for rewards claimed before it was recorded
+	ClaimedHeight *int64 `json:"claimed_height"`
 }
 
 func (RewardEraSeq) TableName() string {
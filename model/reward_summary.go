@@ -0,0 +1,32 @@
+package model
+
+import "github.com/figment-networks/polkadothub-indexer/types"
+
+const (
+	RewardGroupByEra       RewardGroupBy = "era"
+	RewardGroupByDay       RewardGroupBy = "day"
+	RewardGroupByMonth     RewardGroupBy = "month"
+	RewardGroupByValidator RewardGroupBy = "validator"
+)
+
+type RewardGroupBy string
+
+func (o RewardGroupBy) String() string {
+	return string(o)
+}
+
+func (o RewardGroupBy) Valid() bool {
+	return o == RewardGroupByEra || o == RewardGroupByDay || o == RewardGroupByMonth || o == RewardGroupByValidator
+}
+
+// RewardSummary is sum of rewards of account in group, ie. era (1234), day (2020-11-10), month (2020-11) or validator stash account
+type RewardSummary struct {
+	Key                       string         `json:"key"`
+	Count                     int64          `json:"count"`
+	TotalAmount               types.Quantity `json:"total_amount"`
+	ClaimedAmount             types.Quantity `json:"claimed_amount"`
+	UnclaimedAmount           types.Quantity `json:"unclaimed_amount"`
+	CommissionAmount          types.Quantity `json:"commission_amount"`
+	RewardAmount              types.Quantity `json:"reward_amount"`
+	CommissionAndRewardAmount types.Quantity `json:"commission_and_reward_amount"`
+}
@@ -0,0 +1,48 @@
+package model
+
+import "github.com/figment-networks/polkadothub-indexer/types"
+
+const (
+	// SlashKindSlash is amount slashed from validator or nominator
+	SlashKindSlash SlashKind = "slash"
+	// SlashKindOffence is offence reported on chain, offenders are not known from it
+	SlashKindOffence SlashKind = "offence"
+	// SlashKindOffline is validator or its nominator exposed to validator reported offline
+	SlashKindOffline SlashKind = "offline"
+)
+
+type SlashKind string
+
+func (o SlashKind) String() string {
+	return string(o)
+}
+
+type SlashSeq struct {
+	ID types.ID `json:"id"`
+
+	*Sequence
+
+	Era                   int64          `json:"era"`
+	EventIndex            int64          `json:"event_index"`
+	Kind                  SlashKind      `json:"kind"`
+	StashAccount          string         `json:"stash_account"`
+	ValidatorStashAccount string         `json:"validator_stash_account"`
+	Amount                types.Quantity `json:"amount"`
+	OffenceKind           string         `json:"offence_kind"`
+}
+
+func (SlashSeq) TableName() string {
+	return "slash_sequences"
+}
+
+// IsValidator returns true when slashed account is validator itself
+func (s SlashSeq) IsValidator() bool {
+	return s.StashAccount != "" && s.StashAccount == s.ValidatorStashAccount
+}
+
+func (s *SlashSeq) Valid() bool {
+	return s.Sequence.Valid() &&
+		s.Kind != "" &&
+		(s.Kind == SlashKindOffence || s.StashAccount != "") &&
+		s.Amount.Valid()
+}
@@ -17,6 +17,8 @@ type Syncable struct {
 
 	Height        int64      `json:"height"`
 	Time          types.Time `json:"time"`
+	Hash          string     `json:"hash"`
+	ParentHash    string     `json:"parent_hash"`
 	SpecVersion   string     `json:"spec_version"`
 	ChainUID      string     `json:"chain_uid"`
 	Session       int64      `json:"session"`
@@ -45,6 +47,15 @@ func (s *Syncable) Equal(m Syncable) bool {
 	return s.Height == m.Height
 }
 
+// IsChildOf returns true if syncable builds on top of given parent syncable
+func (s *Syncable) IsChildOf(parent Syncable) bool {
+	if s.ParentHash == "" || parent.Hash == "" {
+		// hashes are not known for syncables indexed before they were recorded
+		return true
+	}
+	return s.ParentHash == parent.Hash
+}
+
 func (s *Syncable) SetStatus(newStatus SyncableStatus) {
 	s.Status = newStatus
 }
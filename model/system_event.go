@@ -12,8 +12,16 @@ const (
 	SystemEventJoinedSet            SystemEventKind = "joined_set"
 	SystemEventLeftSet              SystemEventKind = "left_set"
 	SystemEventMissedNConsecutive   SystemEventKind = "missed_n_consecutive"
+	SystemEventMissedNofM           SystemEventKind = "missed_n_of_m"
 	SystemEventDelegationLeft       SystemEventKind = "delegation_left"
 	SystemEventDelegationJoined     SystemEventKind = "delegation_joined"
+	SystemEventChainReorg           SystemEventKind = "chain_reorg"
+	SystemEventRewardMismatch       SystemEventKind = "reward_mismatch"
+	SystemEventSlashed              SystemEventKind = "slashed"
+	SystemEventOfflineOffence       SystemEventKind = "offline_offence"
+	SystemEventControllerChanged    SystemEventKind = "controller_changed"
+	SystemEventSessionKeysRotated   SystemEventKind = "session_keys_rotated"
+	SystemEventIdentityChanged      SystemEventKind = "identity_changed"
 )
 
 type SystemEventKind string
@@ -39,9 +47,10 @@ type DelegationChangeData struct {
 
 // PercentChangeData is data format for change system events
 type PercentChangeData struct {
-	Before int64   `json:"before"`
-	After  int64   `json:"after"`
-	Change float64 `json:"change"`
+	Before    int64   `json:"before"`
+	After     int64   `json:"after"`
+	Change    float64 `json:"change"`
+	Threshold float64 `json:"threshold"`
 }
 
 // MissedNofMData is data format for missedNofM system events
@@ -56,3 +65,53 @@ type MissedNConsecutive struct {
 	Missed    int64 `json:"missed"`
 	Threshold int64 `json:"threshold"`
 }
+
+// ChainReorgData is data format for chain reorg system events
+type ChainReorgData struct {
+	ForkHeight           int64  `json:"fork_height"`
+	CommonAncestorHeight int64  `json:"common_ancestor_height"`
+	MostRecentHeight     int64  `json:"most_recent_height"`
+	ExpectedParentHash   string `json:"expected_parent_hash"`
+	ActualParentHash     string `json:"actual_parent_hash"`
+}
+
+// RewardMismatchData is data format for reward mismatch system events, it sums up mismatches of all eras of validator claimed at height
+type RewardMismatchData struct {
+	Eras               []RewardMismatchEraData `json:"eras"`
+	MismatchedAccounts int64                   `json:"mismatched_accounts"`
+	PredictedAmount    string                  `json:"predicted_amount"`
+	ActualAmount       string                  `json:"actual_amount"`
+}
+
+// RewardMismatchEraData is mismatch of rewards of single era
+type RewardMismatchEraData struct {
+	Era                int64  `json:"era"`
+	MismatchedAccounts int64  `json:"mismatched_accounts"`
+	PredictedAmount    string `json:"predicted_amount"`
+	ActualAmount       string `json:"actual_amount"`
+}
+
+// SlashedData is data format for slashed system events, nominator can be slashed as staker of several validators at once
+type SlashedData struct {
+	Era                    int64    `json:"era"`
+	ValidatorStashAccounts []string `json:"validator_stash_accounts"`
+	Amount                 string   `json:"amount"`
+}
+
+// OfflineOffenceData is data format for offline offence system events
+type OfflineOffenceData struct {
+	Era                    int64    `json:"era"`
+	ValidatorStashAccounts []string `json:"validator_stash_accounts"`
+}
+
+// ValueChangeData is data format for controller and identity change system events
+type ValueChangeData struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// SessionKeysRotatedData is data format for session keys rotated system events
+type SessionKeysRotatedData struct {
+	Before []string `json:"before"`
+	After  []string `json:"after"`
+}
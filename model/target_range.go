@@ -0,0 +1,36 @@
+package model
+
+// TargetRange is a continuous range of heights for which indexing target was processed
+type TargetRange struct {
+	*Model
+
+	TargetID    int64 `json:"target_id"`
+	StartHeight int64 `json:"start_height"`
+	EndHeight   int64 `json:"end_height"`
+}
+
+func (TargetRange) TableName() string {
+	return "target_ranges"
+}
+
+func (r *TargetRange) Valid() bool {
+	return r.TargetID > 0 &&
+		r.StartHeight >= 0 &&
+		r.EndHeight >= r.StartHeight
+}
+
+func (r *TargetRange) Equal(m TargetRange) bool {
+	return r.TargetID == m.TargetID &&
+		r.StartHeight == m.StartHeight &&
+		r.EndHeight == m.EndHeight
+}
+
+// Contains checks if given height is within range
+func (r *TargetRange) Contains(height int64) bool {
+	return height >= r.StartHeight && height <= r.EndHeight
+}
+
+// Len returns number of heights in range
+func (r *TargetRange) Len() int64 {
+	return r.EndHeight - r.StartHeight + 1
+}
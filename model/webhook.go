@@ -0,0 +1,52 @@
+package model
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/lib/pq"
+)
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+type WebhookDeliveryStatus string
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// WebhookSubscription is subscription to system events of actor delivered to target url
+type WebhookSubscription struct {
+	*Model
+
+	Actor             string         `json:"actor"`
+	Kinds             pq.StringArray `json:"kinds"`
+	Url               string         `json:"url"`
+	Secret            string         `json:"-"`
+	LastHeight        int64          `json:"last_height"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// WebhookDelivery is delivery of system event to webhook subscription
+type WebhookDelivery struct {
+	*Model
+
+	SubscriptionID int64                 `json:"subscription_id"`
+	SystemEventID  int64                 `json:"system_event_id"`
+	Payload        types.Jsonb           `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int64                 `json:"attempts"`
+	NextAttemptAt  *types.Time           `json:"next_attempt_at"`
+	LastStatusCode *int64                `json:"last_status_code"`
+	LastError      *string               `json:"last_error"`
+	DeliveredAt    *types.Time           `json:"delivered_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
@@ -0,0 +1,28 @@
+package store
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/model"
+)
+
+type AccountActivity interface {
+	FindActivityByQuery(query AccountActivityQuery) ([]model.AccountActivity, error)
+}
+
+// AccountActivityQuery filters activity of account. Empty fields are not used as filters
+type AccountActivityQuery struct {
+	StashAccount string
+	Kinds        []model.AccountActivityKind
+	StartHeight  *int64
+	EndHeight    *int64
+
+	// Before returns only activity older than given cursor when set
+	Before *AccountActivityCursor
+	Limit  int64
+}
+
+// AccountActivityCursor is position of activity in results ordered by height, kind and id
+type AccountActivityCursor struct {
+	Height int64
+	Kind   model.AccountActivityKind
+	ID     int64
+}
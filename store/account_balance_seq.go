@@ -0,0 +1,11 @@
+package store
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/model"
+)
+
+type AccountBalanceSeq interface {
+	BulkUpsertBalanceSeqs(records []model.AccountBalanceSeq) error
+	DeleteBalanceSeqsAfterHeight(height int64) error
+	FindLastBalanceSeqByStashAccount(stashAccount string, maxHeight *int64) (*model.AccountBalanceSeq, error)
+}
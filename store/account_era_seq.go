@@ -6,6 +6,7 @@ import (
 
 type AccountEraSeq interface {
 	BulkUpsert(records []model.AccountEraSeq) error
+	DeleteAfterHeight(height int64) error
 	FindByEra(era int64) ([]model.AccountEraSeq, error)
 	FindLastByStashAccount(stashAccount string) ([]model.AccountEraSeq, error)
 	FindLastByValidatorStashAccount(validatorStashAccount string) ([]model.AccountEraSeq, error)
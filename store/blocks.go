@@ -10,6 +10,7 @@ import (
 type BlockSeq interface {
 	CreateSeq(*model.BlockSeq) error
 	DeleteSeqOlderThan(purgeThreshold time.Time, activityPeriods []ActivityPeriodRow) (*int64, error)
+	DeleteSeqsAfterHeight(height int64) error
 	FindSeqByHeight(height int64) (*model.BlockSeq, error)
 	// FindByID(id int64) (*model.BlockSeq, error)
 	FindMostRecentSeq() (*model.BlockSeq, error)
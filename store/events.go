@@ -4,6 +4,7 @@ import "github.com/figment-networks/polkadothub-indexer/model"
 
 type EventSeq interface {
 	BulkUpsert(records []model.EventSeq) error
+	DeleteAfterHeight(height int64) error
 	FindByHeightAndIndex(height int64, index int64) (*model.EventSeq, error)
 	FindBalanceDeposits(address string) ([]model.EventSeqWithTxHash, error)
 	FindBalanceTransfers(address string) ([]model.EventSeqWithTxHash, error)
@@ -0,0 +1,70 @@
+package psql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/store/psql/queries"
+)
+
+// accountActivityAddressParams is number of address placeholders in account activity select
+const accountActivityAddressParams = 10
+
+func NewAccountActivityStore(db *gorm.DB) *AccountActivityStore {
+	return &AccountActivityStore{db: db}
+}
+
+// AccountActivityStore handles operations on account activity, which is gathered from events, rewards,
+// account era sequences and system events
+type AccountActivityStore struct {
+	db *gorm.DB
+}
+
+// FindActivityByQuery returns activity of account matching query, most recent first
+func (s AccountActivityStore) FindActivityByQuery(query store.AccountActivityQuery) ([]model.AccountActivity, error) {
+	args := make([]interface{}, 0, accountActivityAddressParams+6)
+	for i := 0; i < accountActivityAddressParams; i++ {
+		args = append(args, query.StashAccount)
+	}
+
+	conditions := []string{"TRUE"}
+	if len(query.Kinds) > 0 {
+		kinds := make([]string, len(query.Kinds))
+		for i, kind := range query.Kinds {
+			kinds[i] = kind.String()
+		}
+		conditions = append(conditions, "kind IN(?)")
+		args = append(args, kinds)
+	}
+	if query.StartHeight != nil {
+		conditions = append(conditions, "height >= ?")
+		args = append(args, *query.StartHeight)
+	}
+	if query.EndHeight != nil {
+		conditions = append(conditions, "height <= ?")
+		args = append(args, *query.EndHeight)
+	}
+	if query.Before != nil {
+		conditions = append(conditions, "(height, kind, id) < (?, ?, ?)")
+		args = append(args, query.Before.Height, query.Before.Kind.String(), query.Before.ID)
+	}
+	args = append(args, query.Limit)
+
+	statement := fmt.Sprintf(
+		"SELECT * FROM (%s) AS activities WHERE %s ORDER BY height DESC, kind DESC, id DESC LIMIT ?",
+		queries.AccountActivitySelect,
+		strings.Join(conditions, " AND "),
+	)
+
+	var result []model.AccountActivity
+	err := s.db.
+		Raw(statement, args...).
+		Scan(&result).
+		Error
+
+	return result, checkErr(err)
+}
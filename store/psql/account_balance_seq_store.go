@@ -0,0 +1,78 @@
+package psql
+
+import (
+	"github.com/jinzhu/gorm"
+
+	"github.com/figment-networks/indexing-engine/store/bulk"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store/psql/queries"
+)
+
+func NewAccountBalanceSeqStore(db *gorm.DB) *AccountBalanceSeqStore {
+	return &AccountBalanceSeqStore{scoped(db, model.AccountBalanceSeq{})}
+}
+
+// AccountBalanceSeqStore handles operations on account balance sequences
+type AccountBalanceSeqStore struct {
+	baseStore
+}
+
+// BulkUpsertBalanceSeqs imports new records and updates existing ones
+func (s AccountBalanceSeqStore) BulkUpsertBalanceSeqs(records []model.AccountBalanceSeq) error {
+	var err error
+
+	for i := 0; i < len(records); i += batchSize {
+		j := i + batchSize
+		if j > len(records) {
+			j = len(records)
+		}
+
+		err = s.Import(queries.AccountBalanceSeqInsert, j-i, func(k int) bulk.Row {
+			r := records[i+k]
+			return bulk.Row{
+				r.Height,
+				r.Time,
+				r.StashAccount,
+				r.Nonce,
+				r.ReferendumCount,
+				r.Free.String(),
+				r.Reserved.String(),
+				r.MiscFrozen.String(),
+				r.FeeFrozen.String(),
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteBalanceSeqsAfterHeight deletes account balance sequences above given height
+func (s AccountBalanceSeqStore) DeleteBalanceSeqsAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("height > ?", height).
+		Delete(&model.AccountBalanceSeq{}).
+		Error
+
+	return checkErr(err)
+}
+
+// FindLastBalanceSeqByStashAccount finds most recent balance snapshot of account, at or below max height when it's given
+func (s AccountBalanceSeqStore) FindLastBalanceSeqByStashAccount(stashAccount string, maxHeight *int64) (*model.AccountBalanceSeq, error) {
+	tx := s.db.
+		Where("stash_account = ?", stashAccount)
+
+	if maxHeight != nil {
+		tx = tx.Where("height <= ?", *maxHeight)
+	}
+
+	result := &model.AccountBalanceSeq{}
+	err := tx.
+		Order("height DESC").
+		First(result).
+		Error
+
+	return result, checkErr(err)
+}
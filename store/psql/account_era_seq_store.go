@@ -48,6 +48,17 @@ func (s AccountEraSeqStore) BulkUpsert(records []model.AccountEraSeq) error {
 	return nil
 }
 
+// DeleteAfterHeight deletes account era sequences which end above given height
+func (s AccountEraSeqStore) DeleteAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("end_height > ?", height).
+		Delete(&model.AccountEraSeq{}).
+		Error
+
+	return checkErr(err)
+}
+
 // FindByHeight finds account era sequences by era
 func (s AccountEraSeqStore) FindByEra(era int64) ([]model.AccountEraSeq, error) {
 	q := model.AccountEraSeq{
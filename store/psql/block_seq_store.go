@@ -113,6 +113,17 @@ func (s *BlockSeqStore) DeleteSeqOlderThan(purgeThreshold time.Time, activityPer
 	return &tx.RowsAffected, nil
 }
 
+// DeleteSeqsAfterHeight deletes block sequences above given height
+func (s *BlockSeqStore) DeleteSeqsAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("height > ?", height).
+		Delete(&model.BlockSeq{}).
+		Error
+
+	return checkErr(err)
+}
+
 // Summarize gets the summarized version of block sequences
 func (s *BlockSeqStore) Summarize(interval types.SummaryInterval, activityPeriods []store.ActivityPeriodRow) ([]model.BlockSeqSummary, error) {
 	defer logQueryDuration(time.Now(), "BlockSummaryStore_Summarize")
@@ -1,7 +1,10 @@
 package psql
 
 import (
+	"time"
+
 	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
 	"github.com/jinzhu/gorm"
 )
 
@@ -27,3 +30,59 @@ func (s *DatabaseStore) GetTotalSize() (*store.GetTotalSizeResult, error) {
 	}
 	return &result, nil
 }
+
+// RollbackAfterHeight removes all indexed data above given height in single transaction.
+// Validator aggregates and cached identities are reverted to given height and identities refreshed after its time are invalidated.
+// Rewards claimed above given height are marked as not claimed, pending webhook deliveries of removed system events are deleted
+// and webhook subscriptions are rewound to given height.
+func (s *DatabaseStore) RollbackAfterHeight(height int64, heightTime time.Time) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		// Aggregates are reverted before session sequences they are reverted from are deleted
+		if err := NewValidatorAggStore(tx).RollbackAggsAfterHeight(height, *types.NewTimeFromTime(heightTime)); err != nil {
+			return err
+		}
+		// Identities are reverted before changes they are reverted from are deleted
+		identitiesStore := NewIdentitiesStore(tx)
+		if err := identitiesStore.RevertChangesAfterHeight(height); err != nil {
+			return err
+		}
+		if err := identitiesStore.InvalidateRefreshedAfter(heightTime); err != nil {
+			return err
+		}
+
+		if err := NewRewardEraSeqStore(tx).UnmarkClaimedAfterHeight(height); err != nil {
+			return err
+		}
+		// Deliveries are deleted before system events they are found by
+		if err := NewWebhookDeliveryStore(tx).DeletePendingForSystemEventsAfterHeight(height); err != nil {
+			return err
+		}
+		if err := NewWebhookSubscriptionStore(tx).RewindAfterHeight(height); err != nil {
+			return err
+		}
+
+		deletes := []func(height int64) error{
+			NewBlockSeqStore(tx).DeleteSeqsAfterHeight,
+			NewEventSeqStore(tx).DeleteAfterHeight,
+			NewTransactionSeqStore(tx).DeleteAfterHeight,
+			NewValidatorSeqStore(tx).DeleteSeqsAfterHeight,
+			NewValidatorSessionSeqStore(tx).DeleteSessionSeqsAfterHeight,
+			NewValidatorEraSeqStore(tx).DeleteEraSeqsAfterHeight,
+			NewAccountEraSeqStore(tx).DeleteAfterHeight,
+			NewAccountBalanceSeqStore(tx).DeleteBalanceSeqsAfterHeight,
+			NewRewardEraSeqStore(tx).DeleteAfterHeight,
+			NewSlashSeqStore(tx).DeleteAfterHeight,
+			NewSystemEventsStore(tx).DeleteAfterHeight,
+			NewFailedHeightsStore(tx).DeleteAfterHeight,
+			identitiesStore.DeleteChangesAfterHeight,
+			NewTargetRangesStore(tx).DeleteAfterHeight,
+			NewSyncablesStore(tx).DeleteAfterHeight,
+		}
+		for _, deleteAfterHeight := range deletes {
+			if err := deleteAfterHeight(height); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -50,6 +50,17 @@ func (s EventSeqStore) BulkUpsert(records []model.EventSeq) error {
 	return nil
 }
 
+// DeleteAfterHeight deletes event sequences above given height
+func (s EventSeqStore) DeleteAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("height > ?", height).
+		Delete(&model.EventSeq{}).
+		Error
+
+	return checkErr(err)
+}
+
 // FindByHeightAndStashAccount finds event by height and index
 func (s EventSeqStore) FindByHeightAndIndex(height int64, index int64) (*model.EventSeq, error) {
 	q := model.EventSeq{
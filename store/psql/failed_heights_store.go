@@ -0,0 +1,49 @@
+package psql
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/jinzhu/gorm"
+)
+
+func NewFailedHeightsStore(db *gorm.DB) *FailedHeightsStore {
+	return &FailedHeightsStore{scoped(db, model.FailedHeight{})}
+}
+
+// FailedHeightsStore handles operations on failed heights
+type FailedHeightsStore struct {
+	baseStore
+}
+
+// FindUnresolved returns failed heights which were not indexed successfully yet
+func (s FailedHeightsStore) FindUnresolved() ([]model.FailedHeight, error) {
+	var result []model.FailedHeight
+
+	err := s.db.
+		Where("resolved_at IS NULL").
+		Order("height").
+		Find(&result).Error
+
+	return result, checkErr(err)
+}
+
+// FindByHeight returns failed height for given height, whether it was resolved or not
+func (s FailedHeightsStore) FindByHeight(height int64) (*model.FailedHeight, error) {
+	result := &model.FailedHeight{}
+
+	err := s.db.
+		Where("height = ?", height).
+		First(result).Error
+
+	return result, checkErr(err)
+}
+
+// DeleteAfterHeight deletes failed heights above given height
+func (s FailedHeightsStore) DeleteAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("height > ?", height).
+		Delete(&model.FailedHeight{}).
+		Error
+
+	return checkErr(err)
+}
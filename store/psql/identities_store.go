@@ -0,0 +1,161 @@
+package psql
+
+import (
+	"time"
+
+	"github.com/figment-networks/indexing-engine/store/bulk"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store/psql/queries"
+	"github.com/jinzhu/gorm"
+)
+
+func NewIdentitiesStore(db *gorm.DB) *IdentitiesStore {
+	return &IdentitiesStore{scoped(db, model.Identity{})}
+}
+
+// IdentitiesStore handles operations on identities and their changes
+type IdentitiesStore struct {
+	baseStore
+}
+
+// BulkUpsert imports new identities and updates cached ones
+func (s IdentitiesStore) BulkUpsert(records []model.Identity) error {
+	var err error
+	t := time.Now()
+
+	for i := 0; i < len(records); i += batchSize {
+		j := i + batchSize
+		if j > len(records) {
+			j = len(records)
+		}
+
+		err = s.Import(queries.IdentityInsert, j-i, func(k int) bulk.Row {
+			r := records[i+k]
+			return bulk.Row{
+				t,
+				t,
+				r.StashAccount,
+				r.DisplayName,
+				r.RefreshedAt,
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BulkUpsertChanges imports new identity changes and updates existing ones
+func (s IdentitiesStore) BulkUpsertChanges(records []model.IdentityChange) error {
+	var err error
+	t := time.Now()
+
+	for i := 0; i < len(records); i += batchSize {
+		j := i + batchSize
+		if j > len(records) {
+			j = len(records)
+		}
+
+		err = s.Import(queries.IdentityChangeInsert, j-i, func(k int) bulk.Row {
+			r := records[i+k]
+			return bulk.Row{
+				t,
+				t,
+				r.Height,
+				r.Time,
+				r.StashAccount,
+				r.DisplayName,
+				r.PreviousDisplayName,
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindByStashAccounts returns cached identities of given accounts
+func (s IdentitiesStore) FindByStashAccounts(stashAccounts []string) ([]model.Identity, error) {
+	var result []model.Identity
+
+	if len(stashAccounts) == 0 {
+		return result, nil
+	}
+
+	err := s.db.
+		Where("stash_account IN(?)", stashAccounts).
+		Find(&result).Error
+
+	return result, checkErr(err)
+}
+
+// FindChangesByHeight returns identity changes found at given height
+func (s IdentitiesStore) FindChangesByHeight(height int64) ([]model.IdentityChange, error) {
+	var result []model.IdentityChange
+
+	err := s.db.
+		Where("height = ?", height).
+		Order("stash_account").
+		Find(&result).Error
+
+	return result, checkErr(err)
+}
+
+// FindChangesByStashAccount returns changes of identity of account ordered by height
+func (s IdentitiesStore) FindChangesByStashAccount(stashAccount string) ([]model.IdentityChange, error) {
+	var result []model.IdentityChange
+
+	err := s.db.
+		Where("stash_account = ?", stashAccount).
+		Order("height").
+		Find(&result).Error
+
+	return result, checkErr(err)
+}
+
+// FindLastChangesBeforeHeight returns last identity change of each of given accounts found below given height
+func (s IdentitiesStore) FindLastChangesBeforeHeight(stashAccounts []string, height int64) ([]model.IdentityChange, error) {
+	var result []model.IdentityChange
+
+	if len(stashAccounts) == 0 {
+		return result, nil
+	}
+
+	err := s.db.
+		Raw(queries.IdentityChangeFindLastBeforeHeight, stashAccounts, height).
+		Scan(&result).Error
+
+	return result, checkErr(err)
+}
+
+// DeleteChangesAfterHeight deletes identity changes above given height
+func (s IdentitiesStore) DeleteChangesAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("height > ?", height).
+		Delete(&model.IdentityChange{}).
+		Error
+
+	return checkErr(err)
+}
+
+// RevertChangesAfterHeight reverts cached identities to display names they had before changes above given height
+// and marks them as stale, so that they are fetched again
+func (s IdentitiesStore) RevertChangesAfterHeight(height int64) error {
+	err := s.db.Exec(queries.IdentityRevertChanges, height).Error
+
+	return checkErr(err)
+}
+
+// InvalidateRefreshedAfter marks identities refreshed after given time as stale, so that they are fetched again
+func (s IdentitiesStore) InvalidateRefreshedAfter(t time.Time) error {
+	err := s.db.
+		Model(&model.Identity{}).
+		Where("refreshed_at > ?", t).
+		UpdateColumn("refreshed_at", time.Unix(0, 0)).
+		Error
+
+	return checkErr(err)
+}
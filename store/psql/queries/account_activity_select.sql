@@ -0,0 +1,54 @@
+SELECT e.id, e.height, e.time, 'transfer'::TEXT AS kind, COALESCE(t.hash, '') AS tx_hash, e.data::JSONB AS data
+FROM event_sequences AS e
+LEFT JOIN transaction_sequences AS t ON t.height = e.height AND t.index = e.extrinsic_index
+WHERE e.section = 'balances' AND e.method = 'Transfer' AND e.data->0->>'value' = ?
+UNION ALL
+SELECT e.id, e.height, e.time, 'transfer'::TEXT, COALESCE(t.hash, ''), e.data::JSONB
+FROM event_sequences AS e
+LEFT JOIN transaction_sequences AS t ON t.height = e.height AND t.index = e.extrinsic_index
+WHERE e.section = 'balances' AND e.method = 'Transfer' AND e.data->1->>'value' = ? AND e.data->0->>'value' <> e.data->1->>'value'
+UNION ALL
+SELECT e.id, e.height, e.time, 'deposit'::TEXT, COALESCE(t.hash, ''), e.data::JSONB
+FROM event_sequences AS e
+LEFT JOIN transaction_sequences AS t ON t.height = e.height AND t.index = e.extrinsic_index
+WHERE e.section = 'balances' AND e.method = 'Deposit' AND e.data->0->>'value' = ?
+UNION ALL
+SELECT e.id, e.height, e.time, 'bonded'::TEXT, COALESCE(t.hash, ''), e.data::JSONB
+FROM event_sequences AS e
+LEFT JOIN transaction_sequences AS t ON t.height = e.height AND t.index = e.extrinsic_index
+WHERE e.section = 'staking' AND e.method = 'Bonded' AND e.data->0->>'value' = ?
+UNION ALL
+SELECT e.id, e.height, e.time, 'unbonded'::TEXT, COALESCE(t.hash, ''), e.data::JSONB
+FROM event_sequences AS e
+LEFT JOIN transaction_sequences AS t ON t.height = e.height AND t.index = e.extrinsic_index
+WHERE e.section = 'staking' AND e.method = 'Unbonded' AND e.data->0->>'value' = ?
+UNION ALL
+SELECT e.id, e.height, e.time, 'withdrawn'::TEXT, COALESCE(t.hash, ''), e.data::JSONB
+FROM event_sequences AS e
+LEFT JOIN transaction_sequences AS t ON t.height = e.height AND t.index = e.extrinsic_index
+WHERE e.section = 'staking' AND e.method = 'Withdrawn' AND e.data->0->>'value' = ?
+UNION ALL
+SELECT r.id, r.end_height, r.time, 'reward'::TEXT, '',
+  JSONB_BUILD_OBJECT('era', r.era, 'validator_stash_account', r.validator_stash_account, 'amount', r.amount::TEXT, 'kind', r.kind, 'claimed', r.claimed)
+FROM reward_era_sequences AS r
+WHERE r.stash_account = ?
+UNION ALL
+SELECT a.id, a.end_height, a.time, 'delegation_joined'::TEXT, '',
+  JSONB_BUILD_OBJECT('era', a.era, 'validator_stash_account', a.validator_stash_account, 'stake', a.stake::TEXT)
+FROM account_era_sequences AS a
+WHERE a.stash_account = ?
+  AND EXISTS (SELECT 1 FROM account_era_sequences WHERE stash_account = a.stash_account AND era = a.era - 1)
+  AND NOT EXISTS (SELECT 1 FROM account_era_sequences WHERE stash_account = a.stash_account AND era = a.era - 1 AND validator_stash_account = a.validator_stash_account)
+UNION ALL
+SELECT a.id, n.end_height, n.time, 'delegation_left'::TEXT, '',
+  JSONB_BUILD_OBJECT('era', n.era, 'validator_stash_account', a.validator_stash_account, 'stake', a.stake::TEXT)
+FROM account_era_sequences AS a
+INNER JOIN LATERAL (
+  SELECT era, end_height, time FROM account_era_sequences WHERE stash_account = a.stash_account AND era = a.era + 1 LIMIT 1
+) AS n ON TRUE
+WHERE a.stash_account = ?
+  AND NOT EXISTS (SELECT 1 FROM account_era_sequences WHERE stash_account = a.stash_account AND era = a.era + 1 AND validator_stash_account = a.validator_stash_account)
+UNION ALL
+SELECT s.id, s.height, s.time, 'system_event'::TEXT, '', JSONB_BUILD_OBJECT('kind', s.kind, 'data', s.data)
+FROM system_events AS s
+WHERE s.actor = ?
@@ -0,0 +1,21 @@
+INSERT INTO account_balance_sequences (
+  height,
+  time,
+  stash_account,
+  nonce,
+  referendum_count,
+  free,
+  reserved,
+  misc_frozen,
+  fee_frozen
+)
+VALUES @values
+
+ON CONFLICT (stash_account, height) DO UPDATE
+SET
+  nonce            = excluded.nonce,
+  referendum_count = excluded.referendum_count,
+  free             = excluded.free,
+  reserved         = excluded.reserved,
+  misc_frozen      = excluded.misc_frozen,
+  fee_frozen       = excluded.fee_frozen
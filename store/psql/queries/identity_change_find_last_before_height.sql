@@ -0,0 +1,5 @@
+SELECT DISTINCT ON (stash_account) *
+FROM identity_changes
+WHERE stash_account IN (?)
+  AND height < ?
+ORDER BY stash_account, height DESC
@@ -0,0 +1,17 @@
+INSERT INTO identity_changes (
+  created_at,
+  updated_at,
+  height,
+  time,
+  stash_account,
+  display_name,
+  previous_display_name
+)
+VALUES @values
+
+ON CONFLICT (height, stash_account) DO UPDATE
+SET
+  updated_at            = excluded.updated_at,
+  time                  = excluded.time,
+  display_name          = excluded.display_name,
+  previous_display_name = excluded.previous_display_name
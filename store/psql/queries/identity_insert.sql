@@ -0,0 +1,14 @@
+INSERT INTO identities (
+  created_at,
+  updated_at,
+  stash_account,
+  display_name,
+  refreshed_at
+)
+VALUES @values
+
+ON CONFLICT (stash_account) DO UPDATE
+SET
+  updated_at   = excluded.updated_at,
+  display_name = excluded.display_name,
+  refreshed_at = excluded.refreshed_at
@@ -0,0 +1,12 @@
+UPDATE identities AS i
+SET
+  display_name = c.previous_display_name,
+  refreshed_at = to_timestamp(0),
+  updated_at   = NOW()
+FROM (
+  SELECT DISTINCT ON (stash_account) stash_account, previous_display_name
+  FROM identity_changes
+  WHERE height > ?
+  ORDER BY stash_account, height
+) AS c
+WHERE i.stash_account = c.stash_account
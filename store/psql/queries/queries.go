@@ -3,6 +3,12 @@ package queries
 
 const (
 	
+	// store/psql/queries/account_activity_select.sql
+	AccountActivitySelect = `SELECT e.id, e.height, e.time, 'transfer'::TEXT AS kind, COALESCE(t.hash, '') AS tx_hash, e.data::JSONB AS data FROM event_sequences AS e LEFT JOIN transaction_sequences AS t ON t.height = e.height AND t.index = e.extrinsic_index WHERE e.section = 'balances' AND e.method = 'Transfer' AND e.data->0->>'value' = ? UNION ALL SELECT e.id, e.height, e.time, 'transfer'::TEXT, COALESCE(t.hash, ''), e.data::JSONB FROM event_sequences AS e LEFT JOIN transaction_sequences AS t ON t.height = e.height AND t.index = e.extrinsic_index WHERE e.section = 'balances' AND e.method = 'Transfer' AND e.data->1->>'value' = ? AND e.data->0->>'value' <> e.data->1->>'value' UNION ALL SELECT e.id, e.height, e.time, 'deposit'::TEXT, COALESCE(t.hash, ''), e.data::JSONB FROM event_sequences AS e LEFT JOIN transaction_sequences AS t ON t.height = e.height AND t.index = e.extrinsic_index WHERE e.section = 'balances' AND e.method = 'Deposit' AND e.data->0->>'value' = ? UNION ALL SELECT e.id, e.height, e.time, 'bonded'::TEXT, COALESCE(t.hash, ''), e.data::JSONB FROM event_sequences AS e LEFT JOIN transaction_sequences AS t ON t.height = e.height AND t.index = e.extrinsic_index WHERE e.section = 'staking' AND e.method = 'Bonded' AND e.data->0->>'value' = ? UNION ALL SELECT e.id, e.height, e.time, 'unbonded'::TEXT, COALESCE(t.hash, ''), e.data::JSONB FROM event_sequences AS e LEFT JOIN transaction_sequences AS t ON t.height = e.height AND t.index = e.extrinsic_index WHERE e.section = 'staking' AND e.method = 'Unbonded' AND e.data->0->>'value' = ? UNION ALL SELECT e.id, e.height, e.time, 'withdrawn'::TEXT, COALESCE(t.hash, ''), e.data::JSONB FROM event_sequences AS e LEFT JOIN transaction_sequences AS t ON t.height = e.height AND t.index = e.extrinsic_index WHERE e.section = 'staking' AND e.method = 'Withdrawn' AND e.data->0->>'value' = ? UNION ALL SELECT r.id, r.end_height, r.time, 'reward'::TEXT, '',   JSONB_BUILD_OBJECT('era', r.era, 'validator_stash_account', r.validator_stash_account, 'amount', r.amount::TEXT, 'kind', r.kind, 'claimed', r.claimed) FROM reward_era_sequences AS r WHERE r.stash_account = ? UNION ALL SELECT a.id, a.end_height, a.time, 'delegation_joined'::TEXT, '',   JSONB_BUILD_OBJECT('era', a.era, 'validator_stash_account', a.validator_stash_account, 'stake', a.stake::TEXT) FROM account_era_sequences AS a WHERE a.stash_account = ?   AND EXISTS (SELECT 1 FROM account_era_sequences WHERE stash_account = a.stash_account AND era = a.era - 1)   AND NOT EXISTS (SELECT 1 FROM account_era_sequences WHERE stash_account = a.stash_account AND era = a.era - 1 AND validator_stash_account = a.validator_stash_account) UNION ALL SELECT a.id, n.end_height, n.time, 'delegation_left'::TEXT, '',   JSONB_BUILD_OBJECT('era', n.era, 'validator_stash_account', a.validator_stash_account, 'stake', a.stake::TEXT) FROM account_era_sequences AS a INNER JOIN LATERAL (   SELECT era, end_height, time FROM account_era_sequences WHERE stash_account = a.stash_account AND era = a.era + 1 LIMIT 1 ) AS n ON TRUE WHERE a.stash_account = ?   AND NOT EXISTS (SELECT 1 FROM account_era_sequences WHERE stash_account = a.stash_account AND era = a.era + 1 AND validator_stash_account = a.validator_stash_account) UNION ALL SELECT s.id, s.height, s.time, 'system_event'::TEXT, '', JSONB_BUILD_OBJECT('kind', s.kind, 'data', s.data) FROM system_events AS s WHERE s.actor = ? `
+	
+	// store/psql/queries/account_balance_seq_insert.sql
+	AccountBalanceSeqInsert = `INSERT INTO account_balance_sequences (   height,   time,   stash_account,   nonce,   referendum_count,   free,   reserved,   misc_frozen,   fee_frozen ) VALUES @values  ON CONFLICT (stash_account, height) DO UPDATE SET   nonce            = excluded.nonce,   referendum_count = excluded.referendum_count,   free             = excluded.free,   reserved         = excluded.reserved,   misc_frozen      = excluded.misc_frozen,   fee_frozen       = excluded.fee_frozen `
+	
 	// store/psql/queries/account_era_seq_find_last_by_stash.sql
 	AccountEraSeqFindLastByStash = `SELECT * FROM account_era_sequences   WHERE stash_account = ?   AND era = ( 	SELECT era  		FROM account_era_sequences  		WHERE stash_account = ?  		GROUP BY era  		ORDER BY era LIMIT 1);`
 	
@@ -33,15 +39,42 @@ const (
 	// store/psql/queries/event_seq_with_tx_hash_for_src_and_target.sql
 	EventSeqWithTxHashForSrcAndTarget = `	SELECT 		e.height, 		e.method, 		e.section, 		e.data, 		t.hash 	FROM event_sequences AS e 	INNER JOIN transaction_sequences as t 		ON t.height = e.height AND t.index = e.extrinsic_index 	WHERE e.section = ? AND e.method = ? AND (e.data->0->>'value' = ? OR e.data->1->>'value' = ?)`
 	
+	// store/psql/queries/identity_change_find_last_before_height.sql
+	IdentityChangeFindLastBeforeHeight = `SELECT DISTINCT ON (stash_account) * FROM identity_changes WHERE stash_account IN (?)   AND height < ? ORDER BY stash_account, height DESC `
+	
+	// store/psql/queries/identity_change_insert.sql
+	IdentityChangeInsert = `INSERT INTO identity_changes (   created_at,   updated_at,   height,   time,   stash_account,   display_name,   previous_display_name ) VALUES @values  ON CONFLICT (height, stash_account) DO UPDATE SET   updated_at            = excluded.updated_at,   time                  = excluded.time,   display_name          = excluded.display_name,   previous_display_name = excluded.previous_display_name `
+	
+	// store/psql/queries/identity_insert.sql
+	IdentityInsert = `INSERT INTO identities (   created_at,   updated_at,   stash_account,   display_name,   refreshed_at ) VALUES @values  ON CONFLICT (stash_account) DO UPDATE SET   updated_at   = excluded.updated_at,   display_name = excluded.display_name,   refreshed_at = excluded.refreshed_at `
+	
+	// store/psql/queries/identity_revert_changes.sql
+	IdentityRevertChanges = `UPDATE identities AS i SET   display_name = c.previous_display_name,   refreshed_at = to_timestamp(0),   updated_at   = NOW() FROM (   SELECT DISTINCT ON (stash_account) stash_account, previous_display_name   FROM identity_changes   WHERE height > ?   ORDER BY stash_account, height ) AS c WHERE i.stash_account = c.stash_account `
+	
+	// store/psql/queries/reward_discrepancy_insert.sql
+	RewardDiscrepancyInsert = `INSERT INTO reward_discrepancies (   created_at,   updated_at,   height,   time,   era,   stash_account,   validator_stash_account,   predicted_amount,   actual_amount ) VALUES @values  ON CONFLICT (era, stash_account, validator_stash_account) DO UPDATE SET   updated_at       = excluded.updated_at,   height           = excluded.height,   time             = excluded.time,   predicted_amount = excluded.predicted_amount,   actual_amount    = excluded.actual_amount `
+	
 	// store/psql/queries/reward_era_seq_insert.sql
 	RewardEraSeqInsert = `INSERT INTO reward_era_sequences (   era,   start_height,   end_height,   time,   stash_account,   validator_stash_account,   amount,   kind,   claimed ) VALUES @values  ON CONFLICT (era, stash_account, validator_stash_account, kind) DO NOTHING; `
 	
+	// store/psql/queries/reward_era_seq_summarize_select.sql
+	RewardEraSeqSummarizeSelect = `  COUNT(*) AS count,   COALESCE(SUM(amount), 0) AS total_amount,   COALESCE(SUM(CASE WHEN claimed THEN amount ELSE 0 END), 0) AS claimed_amount,   COALESCE(SUM(CASE WHEN claimed THEN 0 ELSE amount END), 0) AS unclaimed_amount,   COALESCE(SUM(CASE WHEN kind = 'commission' THEN amount ELSE 0 END), 0) AS commission_amount,   COALESCE(SUM(CASE WHEN kind = 'reward' THEN amount ELSE 0 END), 0) AS reward_amount,   COALESCE(SUM(CASE WHEN kind = 'commission_and_reward' THEN amount ELSE 0 END), 0) AS commission_and_reward_amount `
+	
+	// store/psql/queries/slash_seq_insert.sql
+	SlashSeqInsert = `INSERT INTO slash_sequences (   height,   time,   era,   event_index,   kind,   stash_account,   validator_stash_account,   amount,   offence_kind ) VALUES @values  ON CONFLICT (height, event_index, stash_account) DO UPDATE SET   era                     = excluded.era,   kind                    = excluded.kind,   validator_stash_account = excluded.validator_stash_account,   amount                  = excluded.amount,   offence_kind            = excluded.offence_kind `
+	
 	// store/psql/queries/system_event_insert.sql
 	SystemEventInsert = `INSERT INTO system_events (   created_at,   updated_at,   height,   time,   actor,   kind,   data ) VALUES @values  ON CONFLICT (height, actor, kind) DO UPDATE SET   updated_at   = excluded.updated_at,   data         = excluded.data `
 	
+	// store/psql/queries/target_range_insert_from_syncables.sql
+	TargetRangeInsertFromSyncables = `INSERT INTO target_ranges (   created_at,   updated_at,   target_id,   start_height,   end_height ) SELECT   NOW(),   NOW(),   ?,   MIN(height),   MAX(height) FROM (   SELECT     height,     height - ROW_NUMBER() OVER (ORDER BY height) AS grp   FROM syncables   WHERE index_version >= ? AND processed_at IS NOT NULL ) AS processed GROUP BY grp `
+	
 	// store/psql/queries/transaction_seq_insert.sql
 	TransactionSeqInsert = `INSERT INTO transaction_sequences (   height,   time,   index,   hash,   method,   section ) VALUES @values  ON CONFLICT (height, index) DO UPDATE SET   hash     = excluded.hash,   method   = excluded.method,   section  = excluded.section `
 	
+	// store/psql/queries/validator_agg_rollback_uptime.sql
+	ValidatorAggRollbackUptime = `UPDATE validator_aggregates AS a SET accumulated_uptime       = GREATEST(a.accumulated_uptime - s.online_count, 0),     accumulated_uptime_count = GREATEST(a.accumulated_uptime_count - s.count, 0) FROM (   SELECT stash_account, COUNT(*) AS count, COUNT(*) FILTER (WHERE online) AS online_count   FROM validator_session_sequences   WHERE end_height > ?   GROUP BY stash_account ) AS s WHERE a.stash_account = s.stash_account `
+	
 	// store/psql/queries/validator_era_seq_insert.sql
 	ValidatorEraSeqInsert = `INSERT INTO validator_era_sequences (   era,   start_height,   end_height,   time,   stash_account,   controller_account,   session_accounts,   index,   total_stake,   own_stake,   stakers_stake,   reward_points,   commission,   stakers_count ) VALUES @values  ON CONFLICT (era, stash_account) DO UPDATE SET   controller_account = excluded.controller_account,   session_accounts = excluded.session_accounts,   index = excluded.index,   total_stake = excluded.total_stake,   own_stake = excluded.own_stake,   stakers_stake = excluded.stakers_stake,   reward_points = excluded.reward_points,   commission = excluded.commission,   stakers_count = excluded.stakers_count `
 	
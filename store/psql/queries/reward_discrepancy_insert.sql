@@ -0,0 +1,20 @@
+INSERT INTO reward_discrepancies (
+  created_at,
+  updated_at,
+  height,
+  time,
+  era,
+  stash_account,
+  validator_stash_account,
+  predicted_amount,
+  actual_amount
+)
+VALUES @values
+
+ON CONFLICT (era, stash_account, validator_stash_account) DO UPDATE
+SET
+  updated_at       = excluded.updated_at,
+  height           = excluded.height,
+  time             = excluded.time,
+  predicted_amount = excluded.predicted_amount,
+  actual_amount    = excluded.actual_amount
@@ -0,0 +1,7 @@
+  COUNT(*) AS count,
+  COALESCE(SUM(amount), 0) AS total_amount,
+  COALESCE(SUM(CASE WHEN claimed THEN amount ELSE 0 END), 0) AS claimed_amount,
+  COALESCE(SUM(CASE WHEN claimed THEN 0 ELSE amount END), 0) AS unclaimed_amount,
+  COALESCE(SUM(CASE WHEN kind = 'commission' THEN amount ELSE 0 END), 0) AS commission_amount,
+  COALESCE(SUM(CASE WHEN kind = 'reward' THEN amount ELSE 0 END), 0) AS reward_amount,
+  COALESCE(SUM(CASE WHEN kind = 'commission_and_reward' THEN amount ELSE 0 END), 0) AS commission_and_reward_amount
@@ -0,0 +1,20 @@
+INSERT INTO slash_sequences (
+  height,
+  time,
+  era,
+  event_index,
+  kind,
+  stash_account,
+  validator_stash_account,
+  amount,
+  offence_kind
+)
+VALUES @values
+
+ON CONFLICT (height, event_index, stash_account) DO UPDATE
+SET
+  era                     = excluded.era,
+  kind                    = excluded.kind,
+  validator_stash_account = excluded.validator_stash_account,
+  amount                  = excluded.amount,
+  offence_kind            = excluded.offence_kind
@@ -0,0 +1,21 @@
+INSERT INTO target_ranges (
+  created_at,
+  updated_at,
+  target_id,
+  start_height,
+  end_height
+)
+SELECT
+  NOW(),
+  NOW(),
+  ?,
+  MIN(height),
+  MAX(height)
+FROM (
+  SELECT
+    height,
+    height - ROW_NUMBER() OVER (ORDER BY height) AS grp
+  FROM syncables
+  WHERE index_version >= ? AND processed_at IS NOT NULL
+) AS processed
+GROUP BY grp
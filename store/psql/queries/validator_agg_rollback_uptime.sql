@@ -0,0 +1,10 @@
+UPDATE validator_aggregates AS a
+SET accumulated_uptime       = GREATEST(a.accumulated_uptime - s.online_count, 0),
+    accumulated_uptime_count = GREATEST(a.accumulated_uptime_count - s.count, 0)
+FROM (
+  SELECT stash_account, COUNT(*) AS count, COUNT(*) FILTER (WHERE online) AS online_count
+  FROM validator_session_sequences
+  WHERE end_height > ?
+  GROUP BY stash_account
+) AS s
+WHERE a.stash_account = s.stash_account
@@ -53,6 +53,18 @@ func (s ReportsStore) Last() (*model.Report, error) {
 	return result, checkErr(err)
 }
 
+// FindRecent returns most recent reports
+func (s ReportsStore) FindRecent(limit int64) ([]model.Report, error) {
+	var result []model.Report
+
+	err := s.db.
+		Order("id DESC").
+		Limit(limit).
+		Find(&result).Error
+
+	return result, checkErr(err)
+}
+
 // DeleteByKinds deletes reports with kind reindexing sequential or parallel
 func (s *ReportsStore) DeleteByKinds(kinds []model.ReportKind) error {
 	err := s.db.
@@ -2,6 +2,8 @@ package psql
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/figment-networks/indexing-engine/store/bulk"
 	"github.com/figment-networks/polkadothub-indexer/model"
@@ -12,6 +14,22 @@ import (
 
 var (
 	errExpectedUpdate = errors.New("no rewards were updated")
+
+	// rewardGroupKeys are expressions of group keys of reward summaries
+	rewardGroupKeys = map[model.RewardGroupBy]string{
+		model.RewardGroupByEra:       "CAST(era AS TEXT)",
+		model.RewardGroupByDay:       "TO_CHAR(DATE_TRUNC('day', time), 'YYYY-MM-DD')",
+		model.RewardGroupByMonth:     "TO_CHAR(DATE_TRUNC('month', time), 'YYYY-MM')",
+		model.RewardGroupByValidator: "validator_stash_account",
+	}
+
+	// rewardGroupOrders are orders of reward summaries, most recent groups come first
+	rewardGroupOrders = map[model.RewardGroupBy]string{
+		model.RewardGroupByEra:       "MAX(era) DESC",
+		model.RewardGroupByDay:       "key DESC",
+		model.RewardGroupByMonth:     "key DESC",
+		model.RewardGroupByValidator: "total_amount DESC, key",
+	}
 )
 
 func NewRewardEraSeqStore(db *gorm.DB) *RewardEraSeqStore {
@@ -54,12 +72,64 @@ func (s RewardEraSeqStore) BulkUpsert(records []model.RewardEraSeq) error {
 	return nil
 }
 
-// MarkAllClaimed updates all rewards for validatorStash and era as claimed. Returns error if nothing updates
-func (s RewardEraSeqStore) MarkAllClaimed(validatorStash string, era int64) error {
+// BulkUpsertDiscrepancies imports new reward discrepancies and updates existing ones
+func (s RewardEraSeqStore) BulkUpsertDiscrepancies(records []model.RewardDiscrepancy) error {
+	var err error
+	t := time.Now()
+
+	for i := 0; i < len(records); i += batchSize {
+		j := i + batchSize
+		if j > len(records) {
+			j = len(records)
+		}
+
+		err = s.Import(queries.RewardDiscrepancyInsert, j-i, func(k int) bulk.Row {
+			r := records[i+k]
+			return bulk.Row{
+				t,
+				t,
+				r.Height,
+				r.Time,
+				r.Era,
+				r.StashAccount,
+				r.ValidatorStashAccount,
+				r.PredictedAmount.String(),
+				r.ActualAmount.String(),
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteAfterHeight deletes rewards which end above given height and discrepancies found above given height
+func (s RewardEraSeqStore) DeleteAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("end_height > ?", height).
+		Delete(&model.RewardEraSeq{}).
+		Error
+	if err != nil {
+		return checkErr(err)
+	}
+
+	err = s.db.
+		Unscoped().
+		Where("height > ?", height).
+		Delete(&model.RewardDiscrepancy{}).
+		Error
+
+	return checkErr(err)
+}
+
+// MarkAllClaimed updates all rewards for validatorStash and era as claimed at given height. Returns error if nothing updates
+func (s RewardEraSeqStore) MarkAllClaimed(validatorStash string, era int64, height int64) error {
 	// Update with conditions
 	result := s.db.Model(&model.RewardEraSeq{}).
 		Where("validator_stash_account = ? AND era = ?", validatorStash, era).
-		Update("claimed", true)
+		Updates(map[string]interface{}{"claimed": true, "claimed_height": height})
 	if result.Error != nil {
 		return result.Error
 	}
@@ -71,8 +141,44 @@ func (s RewardEraSeqStore) MarkAllClaimed(validatorStash string, era int64) erro
 	return nil
 }
 
+// UnmarkClaimedAfterHeight marks rewards claimed above given height as not claimed
+func (s RewardEraSeqStore) UnmarkClaimedAfterHeight(height int64) error {
+	err := s.db.
+		Model(&model.RewardEraSeq{}).
+		Where("claimed_height > ?", height).
+		Updates(map[string]interface{}{"claimed": false, "claimed_height": gorm.Expr("NULL")}).
+		Error
+
+	return checkErr(err)
+}
+
 // GetAll Gets all rewards for given stash
 func (s RewardEraSeqStore) GetAll(stash string, start, end int64) ([]model.RewardEraSeq, error) {
+	var res []model.RewardEraSeq
+	return res, s.allScope(stash, start, end).Find(&res).Error
+}
+
+// StreamAll calls fn for each reward of given stash without loading all of them into memory. Stops at first error returned by fn
+func (s RewardEraSeqStore) StreamAll(stash string, start, end int64, fn func(model.RewardEraSeq) error) error {
+	rows, err := s.allScope(stash, start, end).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row model.RewardEraSeq
+		if err := s.db.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (s RewardEraSeqStore) allScope(stash string, start, end int64) *gorm.DB {
 	tx := s.db.
 		Table(model.RewardEraSeq{}.TableName()).
 		Select("*").
@@ -85,9 +191,7 @@ func (s RewardEraSeqStore) GetAll(stash string, start, end int64) ([]model.Rewar
 	if start != 0 {
 		tx = tx.Where("era >= ?", start)
 	}
-
-	var res []model.RewardEraSeq
-	return res, tx.Find(&res).Error
+	return tx
 }
 
 // GetCount returns record count for given validatorStash at era
@@ -105,3 +209,104 @@ func (s RewardEraSeqStore) GetCount(validatorStash string, era int64) (int64, er
 
 	return count, err
 }
+
+// FindByEraAndValidatorStash returns rewards paid out by validator for given era
+func (s RewardEraSeqStore) FindByEraAndValidatorStash(era int64, validatorStash string) ([]model.RewardEraSeq, error) {
+	var res []model.RewardEraSeq
+
+	err := s.db.
+		Table(model.RewardEraSeq{}.TableName()).
+		Where("validator_stash_account = ? AND era = ?", validatorStash, era).
+		Find(&res).
+		Error
+
+	return res, checkErr(err)
+}
+
+// FindDiscrepancies returns reward discrepancies ordered by era, optionally filtered by validator and era range
+func (s RewardEraSeqStore) FindDiscrepancies(validatorStash string, start, end int64) ([]model.RewardDiscrepancy, error) {
+	tx := s.db.
+		Table(model.RewardDiscrepancy{}.TableName()).
+		Order("era DESC, validator_stash_account, stash_account")
+
+	if validatorStash != "" {
+		tx = tx.Where("validator_stash_account = ?", validatorStash)
+	}
+	if end != 0 {
+		tx = tx.Where("era <= ?", end)
+	}
+	if start != 0 {
+		tx = tx.Where("era >= ?", start)
+	}
+
+	var res []model.RewardDiscrepancy
+	return res, checkErr(tx.Find(&res).Error)
+}
+
+// GetSummaries returns page of reward summaries of stash grouped by given key
+func (s RewardEraSeqStore) GetSummaries(stash string, start, end int64, groupBy model.RewardGroupBy, limit, offset int64) ([]model.RewardSummary, error) {
+	defer logQueryDuration(time.Now(), "RewardEraSeqStore_GetSummaries")
+
+	key, ok := rewardGroupKeys[groupBy]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("unknown reward group %s", groupBy))
+	}
+
+	rows, err := s.summaryScope(stash, start, end).
+		Select(fmt.Sprintf("%s AS key, %s", key, queries.RewardEraSeqSummarizeSelect)).
+		Group(key).
+		Order(rewardGroupOrders[groupBy]).
+		Limit(limit).
+		Offset(offset).
+		Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.RewardSummary
+	for rows.Next() {
+		var row model.RewardSummary
+		if err := s.db.ScanRows(rows, &row); err != nil {
+			return nil, err
+		}
+		res = append(res, row)
+	}
+	return res, nil
+}
+
+// GetSummariesTotal returns summary of all rewards of stash and number of groups they fall into
+func (s RewardEraSeqStore) GetSummariesTotal(stash string, start, end int64, groupBy model.RewardGroupBy) (total model.RewardSummary, groupsCount int64, err error) {
+	defer logQueryDuration(time.Now(), "RewardEraSeqStore_GetSummariesTotal")
+
+	key, ok := rewardGroupKeys[groupBy]
+	if !ok {
+		return total, groupsCount, errors.New(fmt.Sprintf("unknown reward group %s", groupBy))
+	}
+
+	var res struct {
+		model.RewardSummary
+		GroupsCount int64
+	}
+
+	err = s.summaryScope(stash, start, end).
+		Select(fmt.Sprintf("COUNT(DISTINCT %s) AS groups_count, %s", key, queries.RewardEraSeqSummarizeSelect)).
+		Scan(&res).
+		Error
+
+	return res.RewardSummary, res.GroupsCount, checkErr(err)
+}
+
+func (s RewardEraSeqStore) summaryScope(stash string, start, end int64) *gorm.DB {
+	tx := s.db.
+		Table(model.RewardEraSeq{}.TableName()).
+		Where("stash_account = ?", stash)
+
+	if end != 0 {
+		tx = tx.Where("era <= ?", end)
+	}
+	if start != 0 {
+		tx = tx.Where("era >= ?", start)
+	}
+	return tx
+}
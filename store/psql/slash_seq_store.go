@@ -0,0 +1,74 @@
+package psql
+
+import (
+	"github.com/jinzhu/gorm"
+
+	"github.com/figment-networks/indexing-engine/store/bulk"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store/psql/queries"
+)
+
+func NewSlashSeqStore(db *gorm.DB) *SlashSeqStore {
+	return &SlashSeqStore{scoped(db, model.SlashSeq{})}
+}
+
+// SlashSeqStore handles operations on slash sequences
+type SlashSeqStore struct {
+	baseStore
+}
+
+// BulkUpsert imports new records and updates existing ones
+func (s SlashSeqStore) BulkUpsert(records []model.SlashSeq) error {
+	var err error
+
+	for i := 0; i < len(records); i += batchSize {
+		j := i + batchSize
+		if j > len(records) {
+			j = len(records)
+		}
+
+		err = s.Import(queries.SlashSeqInsert, j-i, func(k int) bulk.Row {
+			r := records[i+k]
+			return bulk.Row{
+				r.Height,
+				r.Time,
+				r.Era,
+				r.EventIndex,
+				r.Kind,
+				r.StashAccount,
+				r.ValidatorStashAccount,
+				r.Amount.String(),
+				r.OffenceKind,
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteAfterHeight deletes slash sequences above given height
+func (s SlashSeqStore) DeleteAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("height > ?", height).
+		Delete(&model.SlashSeq{}).
+		Error
+
+	return checkErr(err)
+}
+
+// FindByAddress returns slash sequences of account, either slashed itself or as validator of slashed nominators, most recent first
+func (s SlashSeqStore) FindByAddress(address string, minHeight *int64) ([]model.SlashSeq, error) {
+	tx := s.db.
+		Where("stash_account = ? OR validator_stash_account = ?", address, address).
+		Order("height DESC, event_index, stash_account")
+
+	if minHeight != nil {
+		tx = tx.Where("height >= ?", *minHeight)
+	}
+
+	var result []model.SlashSeq
+	return result, checkErr(tx.Find(&result).Error)
+}
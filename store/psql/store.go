@@ -14,33 +14,45 @@ import (
 const batchSize = 500
 
 var (
-	_ store.Accounts     = (*accounts)(nil)
-	_ store.Blocks       = (*blocks)(nil)
-	_ store.Database     = (*database)(nil)
-	_ store.Events       = (*events)(nil)
-	_ store.Reports      = (*reports)(nil)
-	_ store.Rewards      = (*rewards)(nil)
-	_ store.Validators   = (*validators)(nil)
-	_ store.Syncables    = (*syncables)(nil)
-	_ store.SystemEvents = (*systemEvents)(nil)
-	_ store.Transactions = (*transactions)(nil)
+	_ store.Accounts      = (*accounts)(nil)
+	_ store.Blocks        = (*blocks)(nil)
+	_ store.Database      = (*database)(nil)
+	_ store.Events        = (*events)(nil)
+	_ store.FailedHeights = (*failedHeights)(nil)
+	_ store.Identities    = (*identities)(nil)
+	_ store.Reports       = (*reports)(nil)
+	_ store.Rewards       = (*rewards)(nil)
+	_ store.Slashes       = (*slashes)(nil)
+	_ store.Validators    = (*validators)(nil)
+	_ store.Syncables     = (*syncables)(nil)
+	_ store.SystemEvents  = (*systemEvents)(nil)
+	_ store.TargetRanges  = (*targetRanges)(nil)
+	_ store.Transactions  = (*transactions)(nil)
+	_ store.Webhooks      = (*webhooks)(nil)
 )
 
 type Store struct {
-	db           *gorm.DB
-	accounts     *accounts
-	blocks       *blocks
-	database     *database
-	events       *events
-	reports      *reports
-	rewards      *rewards
-	syncables    *syncables
-	systemEvents *systemEvents
-	transactions *transactions
-	validators   *validators
+	db            *gorm.DB
+	accounts      *accounts
+	blocks        *blocks
+	database      *database
+	events        *events
+	failedHeights *failedHeights
+	identities    *identities
+	reports       *reports
+	rewards       *rewards
+	slashes       *slashes
+	syncables     *syncables
+	systemEvents  *systemEvents
+	targetRanges  *targetRanges
+	transactions  *transactions
+	validators    *validators
+	webhooks      *webhooks
 }
 
 type accounts struct {
+	*AccountActivityStore
+	*AccountBalanceSeqStore
 	*AccountEraSeqStore
 }
 
@@ -57,6 +69,14 @@ type events struct {
 	*EventSeqStore
 }
 
+type failedHeights struct {
+	*FailedHeightsStore
+}
+
+type identities struct {
+	*IdentitiesStore
+}
+
 type reports struct {
 	*ReportsStore
 }
@@ -64,6 +84,10 @@ type reports struct {
 type rewards struct {
 	*RewardEraSeqStore
 }
+
+type slashes struct {
+	*SlashSeqStore
+}
 type syncables struct {
 	*SyncablesStore
 }
@@ -71,6 +95,10 @@ type syncables struct {
 type systemEvents struct {
 	*SystemEventStore
 }
+type targetRanges struct {
+	*TargetRangesStore
+}
+
 type transactions struct {
 	*TransactionSeqStore
 }
@@ -83,6 +111,11 @@ type validators struct {
 	*ValidatorSummaryStore
 }
 
+type webhooks struct {
+	*WebhookSubscriptionStore
+	*WebhookDeliveryStore
+}
+
 // New returns a new postgres store from the connection string
 func New(connStr string) (*Store, error) {
 	conn, err := gorm.Open("postgres", connStr)
@@ -106,6 +139,8 @@ func (s *Store) Close() error {
 func (s *Store) GetAccounts() *accounts {
 	if s.accounts == nil {
 		s.accounts = &accounts{
+			NewAccountActivityStore(s.db),
+			NewAccountBalanceSeqStore(s.db),
 			NewAccountEraSeqStore(s.db),
 		}
 	}
@@ -143,6 +178,26 @@ func (s *Store) GetEvents() *events {
 	return s.events
 }
 
+// GetFailedHeights gets failed heights
+func (s *Store) GetFailedHeights() *failedHeights {
+	if s.failedHeights == nil {
+		s.failedHeights = &failedHeights{
+			NewFailedHeightsStore(s.db),
+		}
+	}
+	return s.failedHeights
+}
+
+// GetIdentities gets identities
+func (s *Store) GetIdentities() *identities {
+	if s.identities == nil {
+		s.identities = &identities{
+			NewIdentitiesStore(s.db),
+		}
+	}
+	return s.identities
+}
+
 // GetReports gets reports
 func (s *Store) GetReports() *reports {
 	if s.reports == nil {
@@ -163,6 +218,16 @@ func (s *Store) GetRewards() *rewards {
 	return s.rewards
 }
 
+// GetSlashes gets slashes
+func (s *Store) GetSlashes() *slashes {
+	if s.slashes == nil {
+		s.slashes = &slashes{
+			NewSlashSeqStore(s.db),
+		}
+	}
+	return s.slashes
+}
+
 // GetSyncables gets syncables
 func (s *Store) GetSyncables() *syncables {
 	if s.syncables == nil {
@@ -183,6 +248,16 @@ func (s *Store) GetSystemEvents() *systemEvents {
 	return s.systemEvents
 }
 
+// GetTargetRanges gets target ranges
+func (s *Store) GetTargetRanges() *targetRanges {
+	if s.targetRanges == nil {
+		s.targetRanges = &targetRanges{
+			NewTargetRangesStore(s.db),
+		}
+	}
+	return s.targetRanges
+}
+
 // GetTransactions gets transactions
 func (s *Store) GetTransactions() *transactions {
 	if s.transactions == nil {
@@ -207,6 +282,17 @@ func (s *Store) GetValidators() *validators {
 	return s.validators
 }
 
+// GetWebhooks gets webhooks
+func (s *Store) GetWebhooks() *webhooks {
+	if s.webhooks == nil {
+		s.webhooks = &webhooks{
+			NewWebhookSubscriptionStore(s.db),
+			NewWebhookDeliveryStore(s.db),
+		}
+	}
+	return s.webhooks
+}
+
 // Test checks the connection status
 func (s *Store) Test() error {
 	return s.db.DB().Ping()
@@ -51,6 +51,18 @@ func (s SyncablesStore) FindMostRecent() (*model.Syncable, error) {
 	return result, checkErr(err)
 }
 
+// FindMostRecentProcessed returns the most recent syncable which was processed
+func (s SyncablesStore) FindMostRecentProcessed() (*model.Syncable, error) {
+	result := &model.Syncable{}
+
+	err := s.db.
+		Where("processed_at IS NOT NULL").
+		Order("height desc").
+		First(result).Error
+
+	return result, checkErr(err)
+}
+
 // FindLastInSessionForHeight finds last_in_session syncable for given height
 func (s SyncablesStore) FindLastInSessionForHeight(height int64) (syncable *model.Syncable, err error) {
 	result := &model.Syncable{}
@@ -152,6 +164,17 @@ func (s SyncablesStore) CreateOrUpdate(val *model.Syncable) error {
 	return s.Update(existing)
 }
 
+// DeleteAfterHeight deletes syncables above given height
+func (s SyncablesStore) DeleteAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("height > ?", height).
+		Delete(&model.Syncable{}).
+		Error
+
+	return checkErr(err)
+}
+
 func (s SyncablesStore) SaveSyncable(val *model.Syncable) error {
 	return s.Save(val)
 }
@@ -165,12 +188,12 @@ func (s SyncablesStore) SetProcessedAtForRange(reportID types.ID, startHeight in
 	return checkErr(err)
 }
 
-// FindAllByLastInSessionOrEra returns end syncs of sessions and eras
-func (s SyncablesStore) FindAllByLastInSessionOrEra(indexVersion int64, isLastInSession, isLastInEra bool) ([]model.Syncable, error) {
+// FindAllByLastInSessionOrEra returns end syncs of sessions and eras within given range of heights
+func (s SyncablesStore) FindAllByLastInSessionOrEra(startHeight, endHeight int64, isLastInSession, isLastInEra bool) ([]model.Syncable, error) {
 	result := &[]model.Syncable{}
 
 	err := s.db.
-		Not("index_version = ?", indexVersion).
+		Where("height >= ? AND height <= ?", startHeight, endHeight).
 		Where("last_in_session=? and last_in_era=?", isLastInSession, isLastInEra).
 		Find(result).Error
 
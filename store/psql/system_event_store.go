@@ -5,6 +5,7 @@ import (
 
 	"github.com/figment-networks/indexing-engine/store/bulk"
 	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
 	"github.com/figment-networks/polkadothub-indexer/store/psql/queries"
 	"github.com/jinzhu/gorm"
 )
@@ -47,6 +48,40 @@ func (s SystemEventStore) BulkUpsert(records []model.SystemEvent) error {
 	return nil
 }
 
+// DeleteAfterHeight deletes system events above given height
+func (s SystemEventStore) DeleteAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("height > ?", height).
+		Delete(&model.SystemEvent{}).
+		Error
+
+	return checkErr(err)
+}
+
+// FindInHeightRange returns system events above afterHeight up to toHeight, optionally limited to actor and kinds
+func (s SystemEventStore) FindInHeightRange(afterHeight, toHeight int64, actor string, kinds []string) ([]model.SystemEvent, error) {
+	var result []model.SystemEvent
+
+	statement := s.db.
+		Where("height > ? AND height <= ?", afterHeight, toHeight)
+
+	if actor != "" {
+		statement = statement.Where("actor = ?", actor)
+	}
+
+	if len(kinds) > 0 {
+		statement = statement.Where("kind IN(?)", kinds)
+	}
+
+	err := statement.
+		Order("height, id").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
 // FindByActor returns system events by actor
 func (s SystemEventStore) FindByActor(actorAddress string, kind *model.SystemEventKind, minHeight *int64) ([]model.SystemEvent, error) {
 	var result []model.SystemEvent
@@ -70,6 +105,49 @@ func (s SystemEventStore) FindByActor(actorAddress string, kind *model.SystemEve
 	return result, checkErr(err)
 }
 
+// FindByQuery returns system events matching query, most recent first
+func (s SystemEventStore) FindByQuery(query store.SystemEventQuery) ([]model.SystemEvent, error) {
+	var result []model.SystemEvent
+
+	statement := s.db
+
+	if len(query.Actors) > 0 {
+		statement = statement.Where("actor IN(?)", query.Actors)
+	}
+	if len(query.Kinds) > 0 {
+		statement = statement.Where("kind IN(?)", query.Kinds)
+	}
+	if query.StartHeight != nil {
+		statement = statement.Where("height >= ?", *query.StartHeight)
+	}
+	if query.EndHeight != nil {
+		statement = statement.Where("height <= ?", *query.EndHeight)
+	}
+	if query.StartTime != nil {
+		statement = statement.Where("time >= ?", *query.StartTime)
+	}
+	if query.EndTime != nil {
+		statement = statement.Where("time <= ?", *query.EndTime)
+	}
+	if query.StartEra != nil {
+		statement = statement.Where("height >= (SELECT MIN(height) FROM syncables WHERE era >= ?)", *query.StartEra)
+	}
+	if query.EndEra != nil {
+		statement = statement.Where("height <= (SELECT MAX(height) FROM syncables WHERE era <= ?)", *query.EndEra)
+	}
+	if query.Before != nil {
+		statement = statement.Where("(height, id) < (?, ?)", query.Before.Height, query.Before.ID)
+	}
+
+	err := statement.
+		Order("height DESC, id DESC").
+		Limit(query.Limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
 func (s SystemEventStore) findUnique(height int64, address string, kind model.SystemEventKind) (*model.SystemEvent, error) {
 	q := model.SystemEvent{
 		Height: height,
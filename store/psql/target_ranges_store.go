@@ -0,0 +1,117 @@
+package psql
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store/psql/queries"
+	"github.com/jinzhu/gorm"
+)
+
+func NewTargetRangesStore(db *gorm.DB) *TargetRangesStore {
+	return &TargetRangesStore{scoped(db, model.TargetRange{})}
+}
+
+// TargetRangesStore handles operations on ranges of heights processed by indexing targets
+type TargetRangesStore struct {
+	baseStore
+}
+
+// FindAll returns all target ranges ordered by target and start height
+func (s TargetRangesStore) FindAll() ([]model.TargetRange, error) {
+	var result []model.TargetRange
+
+	err := s.db.
+		Order("target_id, start_height").
+		Find(&result).Error
+
+	return result, checkErr(err)
+}
+
+// MarkProcessed adds height to ranges of given targets, merging ranges which become adjacent
+func (s TargetRangesStore) MarkProcessed(targetIds []int64, height int64) error {
+	if len(targetIds) == 0 {
+		return nil
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var touching []model.TargetRange
+
+		err := tx.
+			Where("target_id IN (?)", targetIds).
+			Where("start_height <= ? AND end_height >= ?", height+1, height-1).
+			Order("target_id, start_height").
+			Find(&touching).Error
+		if err != nil {
+			return checkErr(err)
+		}
+
+		byTarget := make(map[int64][]model.TargetRange)
+		for _, r := range touching {
+			byTarget[r.TargetID] = append(byTarget[r.TargetID], r)
+		}
+
+		for _, targetId := range targetIds {
+			ranges := byTarget[targetId]
+			if len(ranges) == 0 {
+				if err := tx.Create(&model.TargetRange{TargetID: targetId, StartHeight: height, EndHeight: height}).Error; err != nil {
+					return checkErr(err)
+				}
+				continue
+			}
+
+			merged := ranges[0]
+			if merged.Contains(height) && len(ranges) == 1 {
+				continue
+			}
+
+			for _, r := range ranges[1:] {
+				if r.EndHeight > merged.EndHeight {
+					merged.EndHeight = r.EndHeight
+				}
+				if err := tx.Delete(&r).Error; err != nil {
+					return checkErr(err)
+				}
+			}
+			if height < merged.StartHeight {
+				merged.StartHeight = height
+			}
+			if height > merged.EndHeight {
+				merged.EndHeight = height
+			}
+
+			if err := tx.Save(&merged).Error; err != nil {
+				return checkErr(err)
+			}
+		}
+		return nil
+	})
+}
+
+// CreateFromSyncables creates ranges of target from continuous processed syncables with index version at least minIndexVersion
+func (s TargetRangesStore) CreateFromSyncables(targetId int64, minIndexVersion int64) error {
+	err := s.db.
+		Exec(queries.TargetRangeInsertFromSyncables, targetId, minIndexVersion).
+		Error
+
+	return checkErr(err)
+}
+
+// DeleteAfterHeight removes heights above given height from target ranges
+func (s TargetRangesStore) DeleteAfterHeight(height int64) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Where("start_height > ?", height).
+			Delete(&model.TargetRange{}).
+			Error
+		if err != nil {
+			return checkErr(err)
+		}
+
+		err = tx.
+			Model(&model.TargetRange{}).
+			Where("end_height > ?", height).
+			Update("end_height", height).
+			Error
+
+		return checkErr(err)
+	})
+}
@@ -31,3 +31,14 @@ func (s TransactionSeqStore) BulkUpsert(records []model.TransactionSeq) error {
 		}
 	})
 }
+
+// DeleteAfterHeight deletes transaction sequences above given height
+func (s TransactionSeqStore) DeleteAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("height > ?", height).
+		Delete(&model.TransactionSeq{}).
+		Error
+
+	return checkErr(err)
+}
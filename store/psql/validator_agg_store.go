@@ -2,6 +2,8 @@ package psql
 
 import (
 	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store/psql/queries"
+	"github.com/figment-networks/polkadothub-indexer/types"
 	"github.com/jinzhu/gorm"
 )
 
@@ -64,3 +66,33 @@ func (s ValidatorAggStore) All() ([]model.ValidatorAgg, error) {
 
 	return result, checkErr(err)
 }
+
+// RollbackAggsAfterHeight reverts aggregates to state at given height. Aggregates started above height are removed,
+// uptime of sessions ended above height is subtracted and recent heights are moved back to height.
+// Session sequences above height have to be still present when it's called.
+func (s ValidatorAggStore) RollbackAggsAfterHeight(height int64, recentAt types.Time) error {
+	err := s.db.
+		Unscoped().
+		Where("started_at_height > ?", height).
+		Delete(&model.ValidatorAgg{}).
+		Error
+	if err != nil {
+		return checkErr(err)
+	}
+
+	if err := s.db.Exec(queries.ValidatorAggRollbackUptime, height).Error; err != nil {
+		return checkErr(err)
+	}
+
+	err = s.db.
+		Model(&model.ValidatorAgg{}).
+		Where("recent_at_height > ?", height).
+		UpdateColumns(map[string]interface{}{
+			"recent_at_height":           height,
+			"recent_at":                  recentAt,
+			"recent_as_validator_height": gorm.Expr("LEAST(recent_as_validator_height, ?)", height),
+		}).
+		Error
+
+	return checkErr(err)
+}
@@ -160,6 +160,17 @@ func (s *ValidatorEraSeqStore) DeleteEraSeqsOlderThan(purgeThreshold time.Time)
 	return &tx.RowsAffected, nil
 }
 
+// DeleteEraSeqsAfterHeight deletes validator era sequences which end above given height
+func (s *ValidatorEraSeqStore) DeleteEraSeqsAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("end_height > ?", height).
+		Delete(&model.ValidatorEraSeq{}).
+		Error
+
+	return checkErr(err)
+}
+
 // SummarizeEraSeqs gets the summarized version of validator sequences
 func (s *ValidatorEraSeqStore) SummarizeEraSeqs(interval types.SummaryInterval, activityPeriods []store.ActivityPeriodRow) ([]model.ValidatorEraSeqSummary, error) {
 	defer logQueryDuration(time.Now(), "ValidatorEraSeqStore_Summarize")
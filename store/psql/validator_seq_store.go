@@ -78,3 +78,14 @@ func (s *ValidatorSeqStore) DeleteSeqsOlderThan(purgeThreshold time.Time) (*int6
 
 	return &tx.RowsAffected, nil
 }
+
+// DeleteSeqsAfterHeight deletes validator sequences above given height
+func (s *ValidatorSeqStore) DeleteSeqsAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("height > ?", height).
+		Delete(&model.ValidatorSeq{}).
+		Error
+
+	return checkErr(err)
+}
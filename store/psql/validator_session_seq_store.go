@@ -112,6 +112,19 @@ func (s ValidatorSessionSeqStore) FindBySession(session int64) ([]model.Validato
 	return result, checkErr(err)
 }
 
+// FindBySessionRange finds validator session sequences of sessions between start and end (inclusive)
+func (s ValidatorSessionSeqStore) FindBySessionRange(start, end int64) ([]model.ValidatorSessionSeq, error) {
+	var result []model.ValidatorSessionSeq
+
+	err := s.db.
+		Where("session >= ? AND session <= ?", start, end).
+		Order("session").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
 // FindLastSessionSeqByStashAccount finds last validator session sequences for given stash account
 func (s ValidatorSessionSeqStore) FindLastSessionSeqByStashAccount(stashAccount string, limit int64) ([]model.ValidatorSessionSeq, error) {
 	q := model.ValidatorSessionSeq{
@@ -152,6 +165,17 @@ func (s *ValidatorSessionSeqStore) DeleteSessionSeqsOlderThan(purgeThreshold tim
 	return &tx.RowsAffected, nil
 }
 
+// DeleteSessionSeqsAfterHeight deletes validator session sequences which end above given height
+func (s *ValidatorSessionSeqStore) DeleteSessionSeqsAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("end_height > ?", height).
+		Delete(&model.ValidatorSessionSeq{}).
+		Error
+
+	return checkErr(err)
+}
+
 // SummarizeSessionSeqs gets the summarized version of validator sequences
 func (s *ValidatorSessionSeqStore) SummarizeSessionSeqs(interval types.SummaryInterval, activityPeriods []store.ActivityPeriodRow) ([]model.ValidatorSessionSeqSummary, error) {
 	defer logQueryDuration(time.Now(), "ValidatorSessionSeqStore_Summarize")
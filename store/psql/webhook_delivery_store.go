@@ -0,0 +1,73 @@
+package psql
+
+import (
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/jinzhu/gorm"
+)
+
+func NewWebhookDeliveryStore(db *gorm.DB) *WebhookDeliveryStore {
+	return &WebhookDeliveryStore{scoped(db, model.WebhookDelivery{})}
+}
+
+// WebhookDeliveryStore handles operations on webhook deliveries
+type WebhookDeliveryStore struct {
+	baseStore
+}
+
+// CreateDelivery creates webhook delivery unless system event was already queued for subscription
+func (s WebhookDeliveryStore) CreateDelivery(delivery *model.WebhookDelivery) error {
+	err := s.db.
+		Set("gorm:insert_option", "ON CONFLICT (subscription_id, system_event_id) DO NOTHING").
+		Create(delivery).
+		Error
+
+	return checkErr(err)
+}
+
+// FindDeliveriesBySubscription returns most recent deliveries of webhook subscription
+func (s WebhookDeliveryStore) FindDeliveriesBySubscription(subscriptionID int64, limit int64) ([]model.WebhookDelivery, error) {
+	var result []model.WebhookDelivery
+
+	err := s.db.
+		Where("subscription_id = ?", subscriptionID).
+		Order("id DESC").
+		Limit(limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindDueDeliveries returns pending deliveries which should be attempted at given time
+func (s WebhookDeliveryStore) FindDueDeliveries(now time.Time, limit int64) ([]model.WebhookDelivery, error) {
+	var result []model.WebhookDelivery
+
+	err := s.db.
+		Where("status = ?", model.WebhookDeliveryPending).
+		Where("next_attempt_at IS NULL OR next_attempt_at <= ?", now).
+		Order("id").
+		Limit(limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// SaveDelivery updates webhook delivery
+func (s WebhookDeliveryStore) SaveDelivery(delivery *model.WebhookDelivery) error {
+	return s.Update(delivery)
+}
+
+// DeletePendingForSystemEventsAfterHeight deletes deliveries of system events above given height which were not delivered yet
+func (s WebhookDeliveryStore) DeletePendingForSystemEventsAfterHeight(height int64) error {
+	err := s.db.
+		Unscoped().
+		Where("status = ?", model.WebhookDeliveryPending).
+		Where("system_event_id IN (SELECT id FROM system_events WHERE height > ?)", height).
+		Delete(&model.WebhookDelivery{}).
+		Error
+
+	return checkErr(err)
+}
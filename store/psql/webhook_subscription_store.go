@@ -0,0 +1,71 @@
+package psql
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/jinzhu/gorm"
+)
+
+func NewWebhookSubscriptionStore(db *gorm.DB) *WebhookSubscriptionStore {
+	return &WebhookSubscriptionStore{scoped(db, model.WebhookSubscription{})}
+}
+
+// WebhookSubscriptionStore handles operations on webhook subscriptions
+type WebhookSubscriptionStore struct {
+	baseStore
+}
+
+// CreateSubscription creates webhook subscription
+func (s WebhookSubscriptionStore) CreateSubscription(subscription *model.WebhookSubscription) error {
+	return s.Create(subscription)
+}
+
+// DeleteSubscription deletes webhook subscription together with its deliveries
+func (s WebhookSubscriptionStore) DeleteSubscription(id int64) error {
+	tx := s.db.
+		Unscoped().
+		Where("id = ?", id).
+		Delete(&model.WebhookSubscription{})
+
+	if tx.Error != nil {
+		return checkErr(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return checkErr(gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// FindSubscriptionByID returns webhook subscription by id
+func (s WebhookSubscriptionStore) FindSubscriptionByID(id int64) (*model.WebhookSubscription, error) {
+	result := &model.WebhookSubscription{}
+	err := findBy(s.db, result, "id", id)
+	return result, checkErr(err)
+}
+
+// FindSubscriptions returns all webhook subscriptions
+func (s WebhookSubscriptionStore) FindSubscriptions() ([]model.WebhookSubscription, error) {
+	var result []model.WebhookSubscription
+
+	err := s.db.
+		Order("id").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// SaveSubscription updates webhook subscription
+func (s WebhookSubscriptionStore) SaveSubscription(subscription *model.WebhookSubscription) error {
+	return s.Update(subscription)
+}
+
+// RewindAfterHeight moves cursors of subscriptions above given height back to it so events of reindexed heights are delivered
+func (s WebhookSubscriptionStore) RewindAfterHeight(height int64) error {
+	err := s.db.
+		Model(&model.WebhookSubscription{}).
+		Where("last_height > ?", height).
+		UpdateColumn("last_height", height).
+		Error
+
+	return checkErr(err)
+}
@@ -1,10 +1,14 @@
 package store
 
 import (
+	"time"
+
 	"github.com/figment-networks/polkadothub-indexer/model"
 )
 
 type Accounts interface {
+	AccountActivity
+	AccountBalanceSeq
 	AccountEraSeq
 }
 
@@ -15,25 +19,57 @@ type Blocks interface {
 
 type Database interface {
 	GetTotalSize() (*GetTotalSizeResult, error)
+	RollbackAfterHeight(height int64, heightTime time.Time) error
 }
 
 type Events interface {
 	EventSeq
 }
 
+type FailedHeights interface {
+	baseStore
+	DeleteAfterHeight(height int64) error
+	FindByHeight(height int64) (*model.FailedHeight, error)
+	FindUnresolved() ([]model.FailedHeight, error)
+}
+
+type Identities interface {
+	BulkUpsert(records []model.Identity) error
+	BulkUpsertChanges(records []model.IdentityChange) error
+	DeleteChangesAfterHeight(height int64) error
+	FindByStashAccounts(stashAccounts []string) ([]model.Identity, error)
+	FindChangesByHeight(height int64) ([]model.IdentityChange, error)
+	FindChangesByStashAccount(stashAccount string) ([]model.IdentityChange, error)
+	FindLastChangesBeforeHeight(stashAccounts []string, height int64) ([]model.IdentityChange, error)
+}
+
 type Reports interface {
 	baseStore
 	DeleteByKinds(kinds []model.ReportKind) error
 	FindNotCompletedByIndexVersion(indexVersion int64, kinds ...model.ReportKind) (*model.Report, error)
 	FindNotCompletedByKind(kinds ...model.ReportKind) (*model.Report, error)
+	FindRecent(limit int64) ([]model.Report, error)
 	Last() (*model.Report, error)
 }
 
 type Rewards interface {
 	BulkUpsert(records []model.RewardEraSeq) error
-	MarkAllClaimed(validatorStash string, era int64) error
+	BulkUpsertDiscrepancies(records []model.RewardDiscrepancy) error
+	DeleteAfterHeight(height int64) error
+	MarkAllClaimed(validatorStash string, era int64, height int64) error
+	FindByEraAndValidatorStash(era int64, validatorStash string) ([]model.RewardEraSeq, error)
+	FindDiscrepancies(validatorStash string, start, end int64) ([]model.RewardDiscrepancy, error)
 	GetAll(address string, start, end int64) ([]model.RewardEraSeq, error)
 	GetCount(validatorStash string, era int64) (int64, error)
+	GetSummaries(stash string, start, end int64, groupBy model.RewardGroupBy, limit, offset int64) ([]model.RewardSummary, error)
+	GetSummariesTotal(stash string, start, end int64, groupBy model.RewardGroupBy) (model.RewardSummary, int64, error)
+	StreamAll(stash string, start, end int64, fn func(model.RewardEraSeq) error) error
+}
+
+type Slashes interface {
+	BulkUpsert(records []model.SlashSeq) error
+	DeleteAfterHeight(height int64) error
+	FindByAddress(address string, minHeight *int64) ([]model.SlashSeq, error)
 }
 
 type Syncables interface {
@@ -43,7 +79,17 @@ type Syncables interface {
 
 type SystemEvents interface {
 	BulkUpsert(records []model.SystemEvent) error
+	DeleteAfterHeight(height int64) error
 	FindByActor(actorAddress string, kind *model.SystemEventKind, minHeight *int64) ([]model.SystemEvent, error)
+	FindByQuery(query SystemEventQuery) ([]model.SystemEvent, error)
+	FindInHeightRange(afterHeight, toHeight int64, actor string, kinds []string) ([]model.SystemEvent, error)
+}
+
+type TargetRanges interface {
+	CreateFromSyncables(targetId int64, minIndexVersion int64) error
+	DeleteAfterHeight(height int64) error
+	FindAll() ([]model.TargetRange, error)
+	MarkProcessed(targetIds []int64, height int64) error
 }
 
 type Transactions interface {
@@ -58,6 +104,11 @@ type Validators interface {
 	ValidatorSummary
 }
 
+type Webhooks interface {
+	WebhookSubscriptions
+	WebhookDeliveries
+}
+
 type GetTotalSizeResult struct {
 	Size float64 `json:"size"`
 }
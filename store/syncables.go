@@ -7,6 +7,7 @@ import (
 
 type syncables interface {
 	CreateOrUpdate(val *model.Syncable) error
+	DeleteAfterHeight(height int64) error
 	FindByHeight(height int64) (syncable *model.Syncable, err error)
 	FindFirstByDifferentIndexVersion(indexVersion int64) (*model.Syncable, error)
 	FindLastInEra(era int64) (syncable *model.Syncable, err error)
@@ -15,10 +16,11 @@ type syncables interface {
 	FindLastEndOfSession() (syncable *model.Syncable, err error)
 	FindLastInSessionForHeight(height int64) (syncable *model.Syncable, err error)
 	FindMostRecentByDifferentIndexVersion(indexVersion int64) (*model.Syncable, error)
+	FindMostRecentProcessed() (*model.Syncable, error)
 	FindSmallestIndexVersion() (*int64, error)
 	SaveSyncable(*model.Syncable) error
 	SetProcessedAtForRange(reportID types.ID, startHeight int64, endHeight int64) error
-	FindAllByLastInSessionOrEra(indexVersion int64, isLastInSession, isLastInEra bool) ([]model.Syncable, error)
+	FindAllByLastInSessionOrEra(startHeight, endHeight int64, isLastInSession, isLastInEra bool) ([]model.Syncable, error)
 }
 
 type FindMostRecenter interface {
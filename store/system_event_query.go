@@ -0,0 +1,25 @@
+package store
+
+import "time"
+
+// SystemEventQuery filters system events across actors. Empty fields are not used as filters
+type SystemEventQuery struct {
+	Actors      []string
+	Kinds       []string
+	StartHeight *int64
+	EndHeight   *int64
+	StartTime   *time.Time
+	EndTime     *time.Time
+	StartEra    *int64
+	EndEra      *int64
+
+	// Before returns only system events older than given cursor when set
+	Before *SystemEventCursor
+	Limit  int64
+}
+
+// SystemEventCursor is position of system event in results ordered by height and id
+type SystemEventCursor struct {
+	Height int64
+	ID     int64
+}
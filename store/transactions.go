@@ -6,4 +6,5 @@ import (
 
 type TransactionSeq interface {
 	BulkUpsert(records []model.TransactionSeq) error
+	DeleteAfterHeight(height int64) error
 }
@@ -18,6 +18,7 @@ type ValidatorAgg interface {
 
 type ValidatorSeq interface {
 	BulkUpsertSeqs(records []model.ValidatorSeq) error
+	DeleteSeqsAfterHeight(height int64) error
 	DeleteSeqsOlderThan(purgeThreshold time.Time) (*int64, error)
 	FindAllByHeight(height int64) ([]model.ValidatorSeq, error)
 	FindMostRecentSeq() (*model.ValidatorSeq, error)
@@ -25,6 +26,7 @@ type ValidatorSeq interface {
 
 type ValidatorEraSeq interface {
 	BulkUpsertEraSeqs(records []model.ValidatorEraSeq) error
+	DeleteEraSeqsAfterHeight(height int64) error
 	DeleteEraSeqsOlderThan(purgeThreshold time.Time) (*int64, error)
 	FindByEraAndStashAccount(era int64, stash string) (*model.ValidatorEraSeq, error)
 	FindEraSeqsByHeight(h int64) ([]model.ValidatorEraSeq, error)
@@ -36,10 +38,12 @@ type ValidatorEraSeq interface {
 
 type ValidatorSessionSeq interface {
 	BulkUpsertSessionSeqs(records []model.ValidatorSessionSeq) error
+	DeleteSessionSeqsAfterHeight(height int64) error
 	DeleteSessionSeqsOlderThan(purgeThreshold time.Time) (*int64, error)
 	FindSessionSeqsByHeight(h int64) ([]model.ValidatorSessionSeq, error)
 	FindBySession(h int64) ([]model.ValidatorSessionSeq, error)
 	FindBySessionAndStashAccount(session int64, stash string) (*model.ValidatorSessionSeq, error)
+	FindBySessionRange(start, end int64) ([]model.ValidatorSessionSeq, error)
 	FindLastSessionSeqByStashAccount(stashAccount string, limit int64) ([]model.ValidatorSessionSeq, error)
 	FindMostRecentSessionSeq() (*model.ValidatorSessionSeq, error)
 	SummarizeSessionSeqs(interval types.SummaryInterval, activityPeriods []ActivityPeriodRow) ([]model.ValidatorSessionSeqSummary, error)
@@ -0,0 +1,22 @@
+package store
+
+import (
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/model"
+)
+
+type WebhookSubscriptions interface {
+	CreateSubscription(*model.WebhookSubscription) error
+	DeleteSubscription(id int64) error
+	FindSubscriptionByID(id int64) (*model.WebhookSubscription, error)
+	FindSubscriptions() ([]model.WebhookSubscription, error)
+	SaveSubscription(*model.WebhookSubscription) error
+}
+
+type WebhookDeliveries interface {
+	CreateDelivery(*model.WebhookDelivery) error
+	FindDeliveriesBySubscription(subscriptionID int64, limit int64) ([]model.WebhookDelivery, error)
+	FindDueDeliveries(now time.Time, limit int64) ([]model.WebhookDelivery, error)
+	SaveDelivery(*model.WebhookDelivery) error
+}
@@ -0,0 +1,104 @@
+package account
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+const (
+	defaultActivityLimit int64 = 100
+	maxActivityLimit     int64 = 1000
+)
+
+var (
+	ErrInvalidActivityType = errors.New("invalid activity type")
+	ErrInvalidCursor       = errors.New("invalid cursor")
+	ErrInvalidHeightRange  = errors.New("start_height must not be greater than end_height")
+)
+
+type getActivityUseCase struct {
+	accountActivityDb store.AccountActivity
+}
+
+func NewGetActivityUseCase(accountActivityDb store.AccountActivity) *getActivityUseCase {
+	return &getActivityUseCase{
+		accountActivityDb: accountActivityDb,
+	}
+}
+
+// Validate checks types and height range of query
+func (uc *getActivityUseCase) Validate(query store.AccountActivityQuery) error {
+	for _, kind := range query.Kinds {
+		if !isActivityKind(kind) {
+			return ErrInvalidActivityType
+		}
+	}
+	if query.StartHeight != nil && query.EndHeight != nil && *query.StartHeight > *query.EndHeight {
+		return ErrInvalidHeightRange
+	}
+	return nil
+}
+
+// Execute returns page of account activity matching query, most recent first. Next page is requested with returned cursor
+func (uc *getActivityUseCase) Execute(query store.AccountActivityQuery) (*ActivityView, error) {
+	if query.Limit <= 0 {
+		query.Limit = defaultActivityLimit
+	} else if query.Limit > maxActivityLimit {
+		query.Limit = maxActivityLimit
+	}
+	limit := query.Limit
+
+	// One more activity is fetched to find out if there is next page
+	query.Limit = limit + 1
+	activities, err := uc.accountActivityDb.FindActivityByQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if int64(len(activities)) > limit {
+		activities = activities[:limit]
+		last := activities[limit-1]
+		nextCursor = EncodeActivityCursor(store.AccountActivityCursor{Height: last.Height, Kind: last.Kind, ID: last.ID})
+	}
+
+	return ToActivityView(query.StashAccount, activities, nextCursor)
+}
+
+// EncodeActivityCursor returns opaque representation of cursor
+func EncodeActivityCursor(cursor store.AccountActivityCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s:%d", cursor.Height, cursor.Kind, cursor.ID)))
+}
+
+// DecodeActivityCursor parses cursor returned by EncodeActivityCursor
+func DecodeActivityCursor(value string) (*store.AccountActivityCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || !isActivityKind(model.AccountActivityKind(parts[1])) {
+		return nil, ErrInvalidCursor
+	}
+
+	cursor := store.AccountActivityCursor{Kind: model.AccountActivityKind(parts[1])}
+	if _, err := fmt.Sscanf(parts[0]+":"+parts[2], "%d:%d", &cursor.Height, &cursor.ID); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+func isActivityKind(kind model.AccountActivityKind) bool {
+	for _, k := range model.AccountActivityKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package account
+
+import (
+	"errors"
+
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/usecase/http"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*getActivityHttpHandler)(nil)
+)
+
+type getActivityHttpHandler struct {
+	useCase *getActivityUseCase
+
+	accountActivityDb store.AccountActivity
+}
+
+func NewGetActivityHttpHandler(accountActivityDb store.AccountActivity) *getActivityHttpHandler {
+	return &getActivityHttpHandler{
+		accountActivityDb: accountActivityDb,
+	}
+}
+
+type GetActivityRequest struct {
+	StashAccount string   `uri:"stash_account" binding:"required"`
+	Types        []string `form:"type" binding:"-"`
+	StartHeight  *int64   `form:"start_height" binding:"-"`
+	EndHeight    *int64   `form:"end_height" binding:"-"`
+	Cursor       string   `form:"cursor" binding:"-"`
+	Limit        int64    `form:"limit" binding:"-"`
+}
+
+func (h *getActivityHttpHandler) Handle(c *gin.Context) {
+	var req GetActivityRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid stash account"))
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid height, cursor or/and limit"))
+		return
+	}
+
+	query := store.AccountActivityQuery{
+		StashAccount: req.StashAccount,
+		StartHeight:  req.StartHeight,
+		EndHeight:    req.EndHeight,
+		Limit:        req.Limit,
+	}
+	for _, t := range req.Types {
+		query.Kinds = append(query.Kinds, model.AccountActivityKind(t))
+	}
+	if req.Cursor != "" {
+		cursor, err := DecodeActivityCursor(req.Cursor)
+		if err != nil {
+			http.BadRequest(c, err)
+			return
+		}
+		query.Before = cursor
+	}
+
+	if err := h.getUseCase().Validate(query); err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(query)
+	if err != nil {
+		logger.Error(err)
+		http.ServerError(c, err)
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getActivityHttpHandler) getUseCase() *getActivityUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetActivityUseCase(h.accountActivityDb)
+	}
+	return h.useCase
+}
@@ -3,45 +3,56 @@ package account
 import (
 	"errors"
 
-	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/model"
 	"github.com/figment-networks/polkadothub-indexer/store"
 )
 
-type getByHeightUseCase struct {
-	client *client.Client
+var (
+	ErrHeightNotIndexed = errors.New("height is not indexed yet")
+)
 
-	syncablesDb store.FindMostRecenter
+type getByHeightUseCase struct {
+	accountBalanceSeqDb store.AccountBalanceSeq
+	syncablesDb         store.FindMostRecenter
 }
 
-func NewGetByHeightUseCase(c *client.Client, syncablesDb store.FindMostRecenter) *getByHeightUseCase {
+func NewGetByHeightUseCase(accountBalanceSeqDb store.AccountBalanceSeq, syncablesDb store.FindMostRecenter) *getByHeightUseCase {
 	return &getByHeightUseCase{
-		client: c,
-
-		syncablesDb: syncablesDb,
+		accountBalanceSeqDb: accountBalanceSeqDb,
+		syncablesDb:         syncablesDb,
 	}
 }
 
+// Execute returns balances of account from most recent snapshot at or below height
 func (uc *getByHeightUseCase) Execute(address string, height *int64) (*HeightDetailsView, error) {
-	// Get last indexed height
-	mostRecentSynced, err := uc.syncablesDb.FindMostRecent()
-	if err != nil {
+	if err := checkHeightIndexed(uc.syncablesDb, height); err != nil {
 		return nil, err
 	}
-	lastH := mostRecentSynced.Height
 
-	// Show last synced height, if not provided
-	if height == nil {
-		height = &lastH
+	// Account without balance snapshot has empty balances, Height 0 tells that snapshot was not recorded
+	balanceSeq, err := uc.accountBalanceSeqDb.FindLastBalanceSeqByStashAccount(address, height)
+	if err == store.ErrNotFound {
+		balanceSeq = &model.AccountBalanceSeq{Sequence: &model.Sequence{}, StashAccount: address}
+	} else if err != nil {
+		return nil, err
 	}
 
-	if *height > lastH {
-		return nil, errors.New("height is not indexed yet")
+	return ToHeightDetailsView(*balanceSeq), nil
+}
+
+// checkHeightIndexed returns error when given height is above last indexed height
+func checkHeightIndexed(syncablesDb store.FindMostRecenter, height *int64) error {
+	if height == nil {
+		return nil
 	}
 
-	res, err := uc.client.Account.GetByHeight(address, *height)
+	mostRecentSynced, err := syncablesDb.FindMostRecent()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return ToHeightDetailsView(res.GetAccount()), nil
+	if *height > mostRecentSynced.Height {
+		return ErrHeightNotIndexed
+	}
+	return nil
 }
@@ -3,7 +3,6 @@ package account
 import (
 	"errors"
 
-	"github.com/figment-networks/polkadothub-indexer/client"
 	"github.com/figment-networks/polkadothub-indexer/store"
 	"github.com/figment-networks/polkadothub-indexer/types"
 	"github.com/figment-networks/polkadothub-indexer/usecase/http"
@@ -16,17 +15,16 @@ var (
 )
 
 type getByHeightHttpHandler struct {
-	client *client.Client
-
 	useCase *getByHeightUseCase
 
-	syncablesDb store.FindMostRecenter
+	accountBalanceSeqDb store.AccountBalanceSeq
+	syncablesDb         store.FindMostRecenter
 }
 
-func NewGetByHeightHttpHandler(c *client.Client, syncablesDb store.FindMostRecenter) *getByHeightHttpHandler {
+func NewGetByHeightHttpHandler(accountBalanceSeqDb store.AccountBalanceSeq, syncablesDb store.FindMostRecenter) *getByHeightHttpHandler {
 	return &getByHeightHttpHandler{
-		client:      c,
-		syncablesDb: syncablesDb,
+		accountBalanceSeqDb: accountBalanceSeqDb,
+		syncablesDb:         syncablesDb,
 	}
 }
 
@@ -49,9 +47,11 @@ func (h *getByHeightHttpHandler) Handle(c *gin.Context) {
 	}
 
 	ds, err := h.getUseCase().Execute(req.StashAccount, req.Height)
-	if err != nil {
-		logger.Error(err)
-		http.ServerError(c, err)
+	if err == ErrHeightNotIndexed {
+		http.BadRequest(c, err)
+		return
+	}
+	if http.ShouldReturn(c, err) {
 		return
 	}
 
@@ -60,7 +60,7 @@ func (h *getByHeightHttpHandler) Handle(c *gin.Context) {
 
 func (h *getByHeightHttpHandler) getUseCase() *getByHeightUseCase {
 	if h.useCase == nil {
-		return NewGetByHeightUseCase(h.client, h.syncablesDb)
+		return NewGetByHeightUseCase(h.accountBalanceSeqDb, h.syncablesDb)
 	}
 	return h.useCase
 }
@@ -1,47 +1,51 @@
 package account
 
 import (
-	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/model"
 	"github.com/figment-networks/polkadothub-indexer/store"
 )
 
 type getDetailsUseCase struct {
-	client *client.Client
-
-	accountEraSeqDb store.AccountEraSeq
-	eventSeqDb      store.EventSeq
-	syncablesDb     store.Syncables
+	accountDb   store.Accounts
+	eventSeqDb  store.EventSeq
+	identityDb  store.Identities
+	syncablesDb store.Syncables
 }
 
-func NewGetDetailsUseCase(c *client.Client, accountEraSeqDb store.AccountEraSeq, eventSeqDb store.EventSeq, syncablesDb store.Syncables) *getDetailsUseCase {
+func NewGetDetailsUseCase(accountDb store.Accounts, eventSeqDb store.EventSeq, identityDb store.Identities, syncablesDb store.Syncables) *getDetailsUseCase {
 	return &getDetailsUseCase{
-		client: c,
-
-		accountEraSeqDb: accountEraSeqDb,
-		eventSeqDb:      eventSeqDb,
-		syncablesDb:     syncablesDb,
+		accountDb:   accountDb,
+		eventSeqDb:  eventSeqDb,
+		identityDb:  identityDb,
+		syncablesDb: syncablesDb,
 	}
 }
 
-func (uc *getDetailsUseCase) Execute(address string) (DetailsView, error) {
-	// Get last indexed height
-	mostRecentSynced, err := uc.syncablesDb.FindMostRecent()
-	if err != nil {
+// Execute returns details of account with balances from most recent snapshot at or below height
+func (uc *getDetailsUseCase) Execute(address string, height *int64) (DetailsView, error) {
+	if err := checkHeightIndexed(uc.syncablesDb, height); err != nil {
 		return DetailsView{}, err
 	}
-	lastH := mostRecentSynced.Height
 
-	account, err := uc.client.Account.GetByHeight(address, lastH)
-	if err != nil {
+	// Account without balance snapshot is returned without balances
+	balanceSeq, err := uc.accountDb.FindLastBalanceSeqByStashAccount(address, height)
+	if err == store.ErrNotFound {
+		balanceSeq = nil
+	} else if err != nil {
 		return DetailsView{}, err
 	}
 
-	identity, err := uc.client.Account.GetIdentity(address)
+	// Identities are cached for validators only, other accounts are returned without display name
+	identities, err := uc.identityDb.FindByStashAccounts([]string{address})
 	if err != nil {
 		return DetailsView{}, err
 	}
+	var identity *model.Identity
+	if len(identities) > 0 {
+		identity = &identities[0]
+	}
 
-	accountEraSeqs, err := uc.accountEraSeqDb.FindLastByStashAccount(address)
+	accountEraSeqs, err := uc.accountDb.FindLastByStashAccount(address)
 	if err != nil {
 		return DetailsView{}, err
 	}
@@ -71,5 +75,5 @@ func (uc *getDetailsUseCase) Execute(address string) (DetailsView, error) {
 		return DetailsView{}, err
 	}
 
-	return ToDetailsView(address, identity.GetIdentity(), account.GetAccount(), accountEraSeqs, balanceTransfers, balanceDeposits, bonded, unbonded, withdrawn)
+	return ToDetailsView(address, identity, balanceSeq, accountEraSeqs, balanceTransfers, balanceDeposits, bonded, unbonded, withdrawn)
 }
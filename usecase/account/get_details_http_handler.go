@@ -3,7 +3,6 @@ package account
 import (
 	"errors"
 
-	"github.com/figment-networks/polkadothub-indexer/client"
 	"github.com/figment-networks/polkadothub-indexer/store"
 	"github.com/figment-networks/polkadothub-indexer/types"
 	"github.com/figment-networks/polkadothub-indexer/usecase/http"
@@ -17,27 +16,26 @@ var (
 )
 
 type getDetailsHttpHandler struct {
-	client *client.Client
-
 	useCase *getDetailsUseCase
 
-	accountEraSeqDb store.AccountEraSeq
-	eventSeqDb      store.EventSeq
-	syncablesDb     store.Syncables
+	accountDb   store.Accounts
+	eventSeqDb  store.EventSeq
+	identityDb  store.Identities
+	syncablesDb store.Syncables
 }
 
-func NewGetDetailsHttpHandler(c *client.Client, accountEraSeqDb store.AccountEraSeq, eventSeqDb store.EventSeq, syncablesDb store.Syncables) *getDetailsHttpHandler {
+func NewGetDetailsHttpHandler(accountDb store.Accounts, eventSeqDb store.EventSeq, identityDb store.Identities, syncablesDb store.Syncables) *getDetailsHttpHandler {
 	return &getDetailsHttpHandler{
-		client: c,
-
-		accountEraSeqDb: accountEraSeqDb,
-		eventSeqDb:      eventSeqDb,
-		syncablesDb:     syncablesDb,
+		accountDb:   accountDb,
+		eventSeqDb:  eventSeqDb,
+		identityDb:  identityDb,
+		syncablesDb: syncablesDb,
 	}
 }
 
 type GetDetailsRequest struct {
 	StashAccount string `uri:"stash_account" binding:"required"`
+	Height       *int64 `form:"height" binding:"-"`
 }
 
 func (h *getDetailsHttpHandler) Handle(c *gin.Context) {
@@ -47,8 +45,17 @@ func (h *getDetailsHttpHandler) Handle(c *gin.Context) {
 		http.BadRequest(c, errors.New("invalid stash account"))
 		return
 	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid height"))
+		return
+	}
 
-	ds, err := h.getUseCase().Execute(req.StashAccount)
+	ds, err := h.getUseCase().Execute(req.StashAccount, req.Height)
+	if err == ErrHeightNotIndexed {
+		http.BadRequest(c, err)
+		return
+	}
 	if err != nil {
 		logger.Error(err)
 		http.ServerError(c, err)
@@ -60,7 +67,7 @@ func (h *getDetailsHttpHandler) Handle(c *gin.Context) {
 
 func (h *getDetailsHttpHandler) getUseCase() *getDetailsUseCase {
 	if h.useCase == nil {
-		return NewGetDetailsUseCase(h.client, h.accountEraSeqDb, h.eventSeqDb, h.syncablesDb)
+		return NewGetDetailsUseCase(h.accountDb, h.eventSeqDb, h.identityDb, h.syncablesDb)
 	}
 	return h.useCase
 }
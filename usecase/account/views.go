@@ -3,11 +3,10 @@ package account
 import (
 	"encoding/json"
 	"errors"
-	"strings"
 
 	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/types"
 	"github.com/figment-networks/polkadothub-indexer/usecase/common"
-	"github.com/figment-networks/polkadothub-proxy/grpc/account/accountpb"
 )
 
 var (
@@ -15,7 +14,9 @@ var (
 	ErrUnmarshalingEventData = errors.New("error when trying to unmarshal event data")
 )
 
+// HeightDetailsView is balance snapshot of account, Height is height at which snapshot was recorded
 type HeightDetailsView struct {
+	Height          int64  `json:"height"`
 	Nonce           int64  `json:"nonce"`
 	ReferendumCount int64  `json:"referendum_count"`
 	Free            string `json:"free"`
@@ -24,14 +25,15 @@ type HeightDetailsView struct {
 	FeeFrozen       string `json:"fee_frozen"`
 }
 
-func ToHeightDetailsView(rawAccount *accountpb.Account) *HeightDetailsView {
+func ToHeightDetailsView(balanceSeq model.AccountBalanceSeq) *HeightDetailsView {
 	return &HeightDetailsView{
-		Nonce:           rawAccount.GetNonce(),
-		ReferendumCount: rawAccount.GetReferendumCount(),
-		Free:            rawAccount.GetFree(),
-		Reserved:        rawAccount.GetReserved(),
-		MiscFrozen:      rawAccount.GetMiscFrozen(),
-		FeeFrozen:       rawAccount.GetFeeFrozen(),
+		Height:          balanceSeq.Height,
+		Nonce:           balanceSeq.Nonce,
+		ReferendumCount: balanceSeq.ReferendumCount,
+		Free:            balanceSeq.Free.String(),
+		Reserved:        balanceSeq.Reserved.String(),
+		MiscFrozen:      balanceSeq.MiscFrozen.String(),
+		FeeFrozen:       balanceSeq.FeeFrozen.String(),
 	}
 }
 
@@ -48,11 +50,11 @@ type DetailsView struct {
 	Delegations []*common.Delegation `json:"delegations"`
 }
 
-func ToDetailsView(address string, rawAccountIdentity *accountpb.AccountIdentity, rawAccount *accountpb.Account, accountEraSeqs []model.AccountEraSeq, balanceTransferModels, balanceDepositModels, bondedModels, unbondedModels, withdrawnModels []model.EventSeqWithTxHash) (DetailsView, error) {
+func ToDetailsView(address string, identity *model.Identity, balanceSeq *model.AccountBalanceSeq, accountEraSeqs []model.AccountEraSeq, balanceTransferModels, balanceDepositModels, bondedModels, unbondedModels, withdrawnModels []model.EventSeqWithTxHash) (DetailsView, error) {
 	view := DetailsView{
 		Address:     address,
-		Account:     ToAccount(rawAccount),
-		Identity:    ToIdentity(rawAccountIdentity),
+		Account:     ToAccount(balanceSeq),
+		Identity:    ToIdentity(identity),
 		Delegations: common.ToDelegations(accountEraSeqs),
 	}
 
@@ -89,43 +91,44 @@ func ToDetailsView(address string, rawAccountIdentity *accountpb.AccountIdentity
 	return view, nil
 }
 
+// Identity is identity of account cached by indexer, only display name is indexed
 type Identity struct {
-	Deposit     string `json:"deposit"`
 	DisplayName string `json:"display_name"`
-	LegalName   string `json:"legal_name"`
-	WebName     string `json:"web_name"`
-	RiotName    string `json:"riot_name"`
-	EmailName   string `json:"email_name"`
-	TwitterName string `json:"twitter_name"`
-	Image       string `json:"image"`
 }
 
-func ToIdentity(rawAccountIdentity *accountpb.AccountIdentity) *Identity {
+// ToIdentity returns identity with empty display name when account has no cached identity
+func ToIdentity(identity *model.Identity) *Identity {
+	if identity == nil {
+		return &Identity{}
+	}
 	return &Identity{
-		Deposit:     rawAccountIdentity.GetDeposit(),
-		DisplayName: strings.TrimSpace(rawAccountIdentity.GetDisplayName()),
-		LegalName:   strings.TrimSpace(rawAccountIdentity.GetLegalName()),
-		WebName:     strings.TrimSpace(rawAccountIdentity.GetWebName()),
-		RiotName:    strings.TrimSpace(rawAccountIdentity.GetRiotName()),
-		EmailName:   strings.TrimSpace(rawAccountIdentity.GetEmailName()),
-		TwitterName: strings.TrimSpace(rawAccountIdentity.GetTwitterName()),
-		Image:       rawAccountIdentity.GetImage(),
+		DisplayName: identity.DisplayName,
 	}
 }
 
 type Account struct {
-	Free       string `json:"free"`
-	Reserved   string `json:"reserved"`
-	MiscFrozen string `json:"misc_frozen"`
-	FeeFrozen  string `json:"fee_frozen"`
+	Height          int64  `json:"height"`
+	Nonce           int64  `json:"nonce"`
+	ReferendumCount int64  `json:"referendum_count"`
+	Free            string `json:"free"`
+	Reserved        string `json:"reserved"`
+	MiscFrozen      string `json:"misc_frozen"`
+	FeeFrozen       string `json:"fee_frozen"`
 }
 
-func ToAccount(rawAccount *accountpb.Account) *Account {
+// ToAccount returns balances of account, nil when account has no balance snapshot yet
+func ToAccount(balanceSeq *model.AccountBalanceSeq) *Account {
+	if balanceSeq == nil {
+		return nil
+	}
 	return &Account{
-		Free:       rawAccount.GetFree(),
-		Reserved:   rawAccount.GetReserved(),
-		MiscFrozen: rawAccount.GetMiscFrozen(),
-		FeeFrozen:  rawAccount.GetFeeFrozen(),
+		Height:          balanceSeq.Height,
+		Nonce:           balanceSeq.Nonce,
+		ReferendumCount: balanceSeq.ReferendumCount,
+		Free:            balanceSeq.Free.String(),
+		Reserved:        balanceSeq.Reserved.String(),
+		MiscFrozen:      balanceSeq.MiscFrozen.String(),
+		FeeFrozen:       balanceSeq.FeeFrozen.String(),
 	}
 }
 
@@ -299,3 +302,71 @@ func unmarshalEventData(eventSeq model.EventSeqWithTxHash) ([]*EventData, error)
 	}
 	return eventData, nil
 }
+
+// ActivityView is page of account activity. NextCursor is empty on last page
+type ActivityView struct {
+	Items      []ActivityItem `json:"items"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+// ActivityItem is entry of account activity. Data of event based items holds amount (and direction with participant
+// for transfers), data of other items is passed as it is stored
+type ActivityItem struct {
+	Type            model.AccountActivityKind `json:"type"`
+	Height          int64                     `json:"height"`
+	Time            types.Time                `json:"time"`
+	TransactionHash string                    `json:"transaction_hash,omitempty"`
+	Data            interface{}               `json:"data"`
+}
+
+type ActivityTransferData struct {
+	Amount      string `json:"amount"`
+	Kind        string `json:"kind"`
+	Participant string `json:"participant"`
+}
+
+type ActivityAmountData struct {
+	Amount string `json:"amount"`
+}
+
+func ToActivityView(forAddress string, activities []model.AccountActivity, nextCursor string) (*ActivityView, error) {
+	items := make([]ActivityItem, len(activities))
+	for i, activity := range activities {
+		item := ActivityItem{
+			Type:            activity.Kind,
+			Height:          activity.Height,
+			Time:            activity.Time,
+			TransactionHash: activity.TxHash,
+			Data:            activity.Data,
+		}
+
+		switch activity.Kind {
+		case model.AccountActivityTransfer:
+			transfers, err := ToBalanceTransfers(forAddress, []model.EventSeqWithTxHash{{Data: activity.Data}})
+			if err != nil {
+				return nil, err
+			}
+			item.Data = ActivityTransferData{
+				Amount:      transfers[0].Amount,
+				Kind:        transfers[0].Kind,
+				Participant: transfers[0].Participant,
+			}
+		case model.AccountActivityDeposit, model.AccountActivityBonded, model.AccountActivityUnbonded, model.AccountActivityWithdrawn:
+			eventData, err := unmarshalEventData(model.EventSeqWithTxHash{Data: activity.Data})
+			if err != nil {
+				return nil, err
+			}
+			if len(eventData) < 2 {
+				return nil, ErrUnmarshalingEventData
+			}
+			item.Data = ActivityAmountData{Amount: eventData[1].Value}
+		}
+
+		items[i] = item
+	}
+
+	return &ActivityView{
+		Items:      items,
+		NextCursor: nextCursor,
+	}, nil
+}
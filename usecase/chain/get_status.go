@@ -2,20 +2,26 @@ package chain
 
 import (
 	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/indexer"
 	"github.com/figment-networks/polkadothub-indexer/store"
 	"github.com/figment-networks/polkadothub-proxy/grpc/chain/chainpb"
 )
 
 type getStatusUseCase struct {
+	cfg    *config.Config
 	client *client.Client
 
-	syncablesDb store.Syncables
+	syncablesDb   store.Syncables
+	targetRangeDb store.TargetRanges
 }
 
-func NewGetStatusUseCase(c *client.Client, syncablesDb store.Syncables) *getStatusUseCase {
+func NewGetStatusUseCase(cfg *config.Config, c *client.Client, syncablesDb store.Syncables, targetRangeDb store.TargetRanges) *getStatusUseCase {
 	return &getStatusUseCase{
-		syncablesDb: syncablesDb,
-		client:      c,
+		cfg:           cfg,
+		syncablesDb:   syncablesDb,
+		targetRangeDb: targetRangeDb,
+		client:        c,
 	}
 }
 
@@ -47,6 +53,11 @@ func (uc *getStatusUseCase) Execute(includeChainStatus bool) (*DetailsView, erro
 		}
 	}
 
+	targetsCoverage, err := indexer.GetTargetsCoverage(uc.cfg, uc.targetRangeDb)
+	if err != nil {
+		return nil, err
+	}
+
 	if includeChainStatus {
 		getHeadRes, err = uc.client.Chain.GetHead()
 		if err != nil {
@@ -59,5 +70,5 @@ func (uc *getStatusUseCase) Execute(includeChainStatus bool) (*DetailsView, erro
 		}
 	}
 
-	return ToDetailsView(mostRecentSyncable, getHeadRes, getStatusRes, lastSessionHeight, lastEraHeight), nil
+	return ToDetailsView(mostRecentSyncable, getHeadRes, getStatusRes, lastSessionHeight, lastEraHeight, targetsCoverage), nil
 }
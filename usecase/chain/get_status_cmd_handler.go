@@ -5,21 +5,26 @@ import (
 	"fmt"
 
 	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
 	"github.com/figment-networks/polkadothub-indexer/store"
 	"github.com/figment-networks/polkadothub-indexer/utils/logger"
 )
 
 type GetStatusCmdHandler struct {
-	client      *client.Client
-	useCase     *getStatusUseCase
-	syncablesDb store.Syncables
+	cfg           *config.Config
+	client        *client.Client
+	useCase       *getStatusUseCase
+	syncablesDb   store.Syncables
+	targetRangeDb store.TargetRanges
 }
 
-func NewGetStatusCmdHandler(c *client.Client, syncablesDb store.Syncables) *GetStatusCmdHandler {
+func NewGetStatusCmdHandler(cfg *config.Config, c *client.Client, syncablesDb store.Syncables, targetRangeDb store.TargetRanges) *GetStatusCmdHandler {
 	return &GetStatusCmdHandler{
+		cfg:    cfg,
 		client: c,
 
-		syncablesDb: syncablesDb,
+		syncablesDb:   syncablesDb,
+		targetRangeDb: targetRangeDb,
 	}
 }
 
@@ -74,12 +79,19 @@ func (h *GetStatusCmdHandler) Handle(ctx context.Context) {
 		fmt.Println("Last indexed spec version:", details.LastSpecVersion)
 		fmt.Println("Lag behind head:", details.Lag)
 	}
+
+	if len(details.Targets) > 0 {
+		fmt.Println("=== Targets ===")
+		for _, target := range details.Targets {
+			fmt.Println(fmt.Sprintf("  - %d %s: complete=%t ranges=%v missing=%v", target.ID, target.Name, target.Complete, target.Ranges, target.MissingRanges))
+		}
+	}
 	fmt.Println("")
 }
 
 func (h *GetStatusCmdHandler) getUseCase() *getStatusUseCase {
 	if h.useCase == nil {
-		return NewGetStatusUseCase(h.client, h.syncablesDb)
+		return NewGetStatusUseCase(h.cfg, h.client, h.syncablesDb, h.targetRangeDb)
 	}
 	return h.useCase
 }
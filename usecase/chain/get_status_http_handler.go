@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
 	"github.com/figment-networks/polkadothub-indexer/store"
 	"github.com/figment-networks/polkadothub-indexer/types"
 	"github.com/figment-networks/polkadothub-indexer/usecase/http"
@@ -17,17 +18,21 @@ var (
 )
 
 type getStatusHttpHandler struct {
+	cfg    *config.Config
 	client *client.Client
 
 	useCase *getStatusUseCase
 
-	syncablesDb store.Syncables
+	syncablesDb   store.Syncables
+	targetRangeDb store.TargetRanges
 }
 
-func NewGetStatusHttpHandler(client *client.Client, syncablesDb store.Syncables) *getStatusHttpHandler {
+func NewGetStatusHttpHandler(cfg *config.Config, client *client.Client, syncablesDb store.Syncables, targetRangeDb store.TargetRanges) *getStatusHttpHandler {
 	return &getStatusHttpHandler{
-		client:      client,
-		syncablesDb: syncablesDb,
+		cfg:           cfg,
+		client:        client,
+		syncablesDb:   syncablesDb,
+		targetRangeDb: targetRangeDb,
 	}
 }
 
@@ -55,7 +60,7 @@ func (h *getStatusHttpHandler) Handle(c *gin.Context) {
 
 func (h *getStatusHttpHandler) getUseCase() *getStatusUseCase {
 	if h.useCase == nil {
-		return NewGetStatusUseCase(h.client, h.syncablesDb)
+		return NewGetStatusUseCase(h.cfg, h.client, h.syncablesDb, h.targetRangeDb)
 	}
 	return h.useCase
 }
@@ -2,6 +2,7 @@ package chain
 
 import (
 	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/indexer"
 	"github.com/figment-networks/polkadothub-indexer/model"
 	"github.com/figment-networks/polkadothub-indexer/types"
 	"github.com/figment-networks/polkadothub-proxy/grpc/chain/chainpb"
@@ -37,10 +38,25 @@ type DetailsView struct {
 	LastIndexedTime          types.Time `json:"last_indexed_time,omitempty"`
 	LastIndexedAt            types.Time `json:"last_indexed_at,omitempty"`
 	Lag                      int64      `json:"indexing_lag,omitempty"`
+
+	Targets []TargetView `json:"targets,omitempty"`
+}
+
+type TargetView struct {
+	ID            int64             `json:"id"`
+	Name          string            `json:"name"`
+	Complete      bool              `json:"complete"`
+	Ranges        []HeightRangeView `json:"ranges"`
+	MissingRanges []HeightRangeView `json:"missing_ranges"`
+}
+
+type HeightRangeView struct {
+	StartHeight int64 `json:"start_height"`
+	EndHeight   int64 `json:"end_height"`
 }
 
 func ToDetailsView(recentSyncable *model.Syncable, headResponse *chainpb.GetHeadResponse, statusResponse *chainpb.GetStatusResponse,
-	lastSessionHeight int64, lastEraHeight int64) *DetailsView {
+	lastSessionHeight int64, lastEraHeight int64, targetsCoverage []indexer.TargetCoverage) *DetailsView {
 	view := &DetailsView{
 		AppName:    config.AppName,
 		AppVersion: config.AppVersion,
@@ -77,5 +93,26 @@ func ToDetailsView(recentSyncable *model.Syncable, headResponse *chainpb.GetHead
 		}
 	}
 
+	for _, coverage := range targetsCoverage {
+		view.Targets = append(view.Targets, TargetView{
+			ID:            coverage.TargetID,
+			Name:          coverage.Name,
+			Complete:      coverage.IsComplete(),
+			Ranges:        ToHeightRangeViews(coverage.Ranges),
+			MissingRanges: ToHeightRangeViews(coverage.MissingRanges),
+		})
+	}
+
 	return view
 }
+
+func ToHeightRangeViews(ranges []model.TargetRange) []HeightRangeView {
+	views := []HeightRangeView{}
+	for _, r := range ranges {
+		views = append(views, HeightRangeView{
+			StartHeight: r.StartHeight,
+			EndHeight:   r.EndHeight,
+		})
+	}
+	return views
+}
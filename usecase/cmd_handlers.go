@@ -6,24 +6,41 @@ import (
 	"github.com/figment-networks/polkadothub-indexer/store"
 	"github.com/figment-networks/polkadothub-indexer/usecase/chain"
 	"github.com/figment-networks/polkadothub-indexer/usecase/indexing"
+	"github.com/figment-networks/polkadothub-indexer/usecase/report"
+	"github.com/figment-networks/polkadothub-indexer/usecase/reward"
+	"github.com/figment-networks/polkadothub-indexer/usecase/webhook"
 )
 
-func NewCmdHandlers(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, reportDb store.Reports,
-	rewardDb store.Rewards, syncableDb store.Syncables, systemEventDb store.SystemEvents, transactionDb store.Transactions, validatorDb store.Validators,
+func NewCmdHandlers(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators, webhookDb store.Webhooks,
 ) *CmdHandlers {
 	return &CmdHandlers{
-		GetStatus:        chain.NewGetStatusCmdHandler(cli, syncableDb),
-		StartIndexer:     indexing.NewStartCmdHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, reportDb, rewardDb, syncableDb, systemEventDb, transactionDb, validatorDb),
-		BackfillIndexer:  indexing.NewBackfillCmdHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, reportDb, rewardDb, syncableDb, systemEventDb, transactionDb, validatorDb),
-		PurgeIndexer:     indexing.NewPurgeCmdHandler(cfg, blockDb, validatorDb),
-		SummarizeIndexer: indexing.NewSummarizeCmdHandler(cfg, blockDb, validatorDb),
+		GetStatus:          chain.NewGetStatusCmdHandler(cfg, cli, syncableDb, targetRangeDb),
+		StartIndexer:       indexing.NewStartCmdHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, failedHeightDb, identityDb, reportDb, rewardDb, slashDb, syncableDb, systemEventDb, targetRangeDb, transactionDb, validatorDb),
+		StreamIndexer:      indexing.NewStreamCmdHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, failedHeightDb, identityDb, reportDb, rewardDb, slashDb, syncableDb, systemEventDb, targetRangeDb, transactionDb, validatorDb),
+		BackfillIndexer:    indexing.NewBackfillCmdHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, failedHeightDb, identityDb, reportDb, rewardDb, slashDb, syncableDb, systemEventDb, targetRangeDb, transactionDb, validatorDb),
+		ReindexIndexer:     indexing.NewReindexCmdHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, failedHeightDb, identityDb, reportDb, rewardDb, slashDb, syncableDb, systemEventDb, targetRangeDb, transactionDb, validatorDb),
+		RunHeightIndexer:   indexing.NewRunHeightCmdHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, failedHeightDb, identityDb, reportDb, rewardDb, slashDb, syncableDb, systemEventDb, targetRangeDb, transactionDb, validatorDb),
+		RetryFailedIndexer: indexing.NewRetryFailedCmdHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, failedHeightDb, identityDb, reportDb, rewardDb, slashDb, syncableDb, systemEventDb, targetRangeDb, transactionDb, validatorDb),
+		PurgeIndexer:       indexing.NewPurgeCmdHandler(cfg, blockDb, validatorDb),
+		SummarizeIndexer:   indexing.NewSummarizeCmdHandler(cfg, blockDb, validatorDb),
+		GetReports:         report.NewGetListCmdHandler(reportDb),
+		ExportRewards:      reward.NewExportCmdHandler(cfg, rewardDb),
+		DeliverWebhooks:    webhook.NewDeliverCmdHandler(cfg, syncableDb, systemEventDb, webhookDb),
 	}
 }
 
 type CmdHandlers struct {
-	GetStatus        *chain.GetStatusCmdHandler
-	StartIndexer     *indexing.StartCmdHandler
-	BackfillIndexer  *indexing.BackfillCmdHandler
-	PurgeIndexer     *indexing.PurgeCmdHandler
-	SummarizeIndexer *indexing.SummarizeCmdHandler
+	GetStatus          *chain.GetStatusCmdHandler
+	StartIndexer       *indexing.StartCmdHandler
+	StreamIndexer      *indexing.StreamCmdHandler
+	BackfillIndexer    *indexing.BackfillCmdHandler
+	ReindexIndexer     *indexing.ReindexCmdHandler
+	RunHeightIndexer   *indexing.RunHeightCmdHandler
+	RetryFailedIndexer *indexing.RetryFailedCmdHandler
+	PurgeIndexer       *indexing.PurgeCmdHandler
+	SummarizeIndexer   *indexing.SummarizeCmdHandler
+	GetReports         *report.GetListCmdHandler
+	ExportRewards      *reward.ExportCmdHandler
+	DeliverWebhooks    *webhook.DeliverCmdHandler
 }
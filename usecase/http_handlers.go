@@ -9,30 +9,45 @@ import (
 	"github.com/figment-networks/polkadothub-indexer/usecase/block"
 	"github.com/figment-networks/polkadothub-indexer/usecase/chain"
 	"github.com/figment-networks/polkadothub-indexer/usecase/health"
+	"github.com/figment-networks/polkadothub-indexer/usecase/report"
 	"github.com/figment-networks/polkadothub-indexer/usecase/reward"
+	"github.com/figment-networks/polkadothub-indexer/usecase/slash"
 	"github.com/figment-networks/polkadothub-indexer/usecase/system_event"
 	"github.com/figment-networks/polkadothub-indexer/usecase/transaction"
 	"github.com/figment-networks/polkadothub-indexer/usecase/validator"
+	"github.com/figment-networks/polkadothub-indexer/usecase/webhook"
 )
 
-func NewHttpHandlers(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, reportDb store.Reports,
-	rewardDb store.Rewards, syncableDb store.Syncables, systemEventDb store.SystemEvents, transactionDb store.Transactions, validatorDb store.Validators,
+func NewHttpHandlers(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators, webhookDb store.Webhooks,
 ) *HttpHandlers {
 	return &HttpHandlers{
 		Health:                     health.NewHealthHttpHandler(),
-		GetStatus:                  chain.NewGetStatusHttpHandler(cli, syncableDb),
+		GetStatus:                  chain.NewGetStatusHttpHandler(cfg, cli, syncableDb, targetRangeDb),
 		GetBlockByHeight:           block.NewGetByHeightHttpHandler(cli, syncableDb),
 		GetBlockTimes:              block.NewGetBlockTimesHttpHandler(blockDb),
 		GetBlockSummary:            block.NewGetBlockSummaryHttpHandler(blockDb),
 		GetTransactionsByHeight:    transaction.NewGetByHeightHttpHandler(cli, syncableDb),
-		GetAccountByHeight:         account.NewGetByHeightHttpHandler(cli, syncableDb),
-		GetAccountDetails:          account.NewGetDetailsHttpHandler(cli, accountDb, eventDb, syncableDb),
+		GetAccountByHeight:         account.NewGetByHeightHttpHandler(accountDb, syncableDb),
+		GetAccountDetails:          account.NewGetDetailsHttpHandler(accountDb, eventDb, identityDb, syncableDb),
+		GetAccountActivity:         account.NewGetActivityHttpHandler(accountDb),
 		GetSystemEventsForAddress:  system_event.NewGetForAddressHttpHandler(cli, systemEventDb),
-		GetValidatorsByHeight:      validator.NewGetByHeightHttpHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, reportDb, rewardDb, syncableDb, systemEventDb, transactionDb, validatorDb),
-		GetValidatorByStashAccount: validator.NewGetByStashAccountHttpHandler(accountDb, validatorDb),
+		SearchSystemEvents:         system_event.NewSearchHttpHandler(systemEventDb),
+		StreamSystemEvents:         system_event.NewStreamHttpHandler(cfg, syncableDb, systemEventDb),
+		StreamSystemEventsWs:       system_event.NewStreamWsHandler(cfg, syncableDb, systemEventDb),
+		GetValidatorsByHeight:      validator.NewGetByHeightHttpHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, failedHeightDb, identityDb, reportDb, rewardDb, slashDb, syncableDb, systemEventDb, targetRangeDb, transactionDb, validatorDb),
+		GetValidatorByStashAccount: validator.NewGetByStashAccountHttpHandler(accountDb, identityDb, validatorDb),
 		GetValidatorSummary:        validator.NewGetSummaryHttpHandler(syncableDb, validatorDb),
 		GetValidatorsForMinHeight:  validator.NewGetForMinHeightHttpHandler(syncableDb, validatorDb),
 		GetRewardsForStashAccount:  reward.NewGetForStashAccountHttpHandler(rewardDb),
+		GetRewardMismatches:        reward.NewGetMismatchesHttpHandler(rewardDb),
+		GetSlashesForAddress:       slash.NewGetForAddressHttpHandler(slashDb),
+		ExportRewards:              reward.NewExportHttpHandler(cfg, rewardDb),
+		GetReports:                 report.NewGetListHttpHandler(reportDb),
+		CreateWebhook:              webhook.NewCreateHttpHandler(cfg, syncableDb, webhookDb),
+		GetWebhooks:                webhook.NewGetListHttpHandler(webhookDb),
+		DeleteWebhook:              webhook.NewDeleteHttpHandler(webhookDb),
+		GetWebhookDeliveries:       webhook.NewGetDeliveriesHttpHandler(webhookDb),
 	}
 }
 
@@ -45,10 +60,22 @@ type HttpHandlers struct {
 	GetTransactionsByHeight    types.HttpHandler
 	GetAccountByHeight         types.HttpHandler
 	GetAccountDetails          types.HttpHandler
+	GetAccountActivity         types.HttpHandler
 	GetSystemEventsForAddress  types.HttpHandler
+	SearchSystemEvents         types.HttpHandler
+	StreamSystemEvents         types.HttpHandler
+	StreamSystemEventsWs       types.HttpHandler
 	GetValidatorsByHeight      types.HttpHandler
 	GetValidatorByStashAccount types.HttpHandler
 	GetValidatorSummary        types.HttpHandler
 	GetValidatorsForMinHeight  types.HttpHandler
 	GetRewardsForStashAccount  types.HttpHandler
+	GetRewardMismatches        types.HttpHandler
+	GetSlashesForAddress       types.HttpHandler
+	ExportRewards              types.HttpHandler
+	GetReports                 types.HttpHandler
+	CreateWebhook              types.HttpHandler
+	GetWebhooks                types.HttpHandler
+	DeleteWebhook              types.HttpHandler
+	GetWebhookDeliveries       types.HttpHandler
 }
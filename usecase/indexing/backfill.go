@@ -19,35 +19,43 @@ type backfillUseCase struct {
 	cfg    *config.Config
 	client *client.Client
 
-	accountDb     store.Accounts
-	blockDb       store.Blocks
-	databaseDb    store.Database
-	eventDb       store.Events
-	reportDb      store.Reports
-	rewardDb      store.Rewards
-	syncableDb    store.Syncables
-	systemEventDb store.SystemEvents
-	transactionDb store.Transactions
-	validatorDb   store.Validators
+	accountDb      store.Accounts
+	blockDb        store.Blocks
+	databaseDb     store.Database
+	eventDb        store.Events
+	failedHeightDb store.FailedHeights
+	identityDb     store.Identities
+	reportDb       store.Reports
+	rewardDb       store.Rewards
+	slashDb        store.Slashes
+	syncableDb     store.Syncables
+	systemEventDb  store.SystemEvents
+	targetRangeDb  store.TargetRanges
+	transactionDb  store.Transactions
+	validatorDb    store.Validators
 }
 
 func NewBackfillUseCase(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events,
-	reportDb store.Reports, rewardDb store.Rewards, syncableDb store.Syncables, systemEventDb store.SystemEvents, transactionDb store.Transactions, validatorDb store.Validators,
+	failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports, rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
 ) *backfillUseCase {
 	return &backfillUseCase{
 		cfg:    cfg,
 		client: cli,
 
-		accountDb:     accountDb,
-		blockDb:       blockDb,
-		databaseDb:    databaseDb,
-		eventDb:       eventDb,
-		reportDb:      reportDb,
-		rewardDb:      rewardDb,
-		syncableDb:    syncableDb,
-		systemEventDb: systemEventDb,
-		transactionDb: transactionDb,
-		validatorDb:   validatorDb,
+		accountDb:      accountDb,
+		blockDb:        blockDb,
+		databaseDb:     databaseDb,
+		eventDb:        eventDb,
+		failedHeightDb: failedHeightDb,
+		identityDb:     identityDb,
+		reportDb:       reportDb,
+		rewardDb:       rewardDb,
+		slashDb:        slashDb,
+		syncableDb:     syncableDb,
+		systemEventDb:  systemEventDb,
+		targetRangeDb:  targetRangeDb,
+		transactionDb:  transactionDb,
+		validatorDb:    validatorDb,
 	}
 }
 
@@ -62,7 +70,7 @@ func (uc *backfillUseCase) Execute(ctx context.Context, useCaseConfig BackfillUs
 		return err
 	}
 
-	indexingPipeline, err := indexer.NewPipeline(uc.cfg, uc.client, uc.accountDb, uc.blockDb, uc.databaseDb, uc.eventDb, uc.reportDb, uc.rewardDb, uc.syncableDb, uc.systemEventDb, uc.transactionDb, uc.validatorDb)
+	indexingPipeline, err := indexer.NewPipeline(uc.cfg, uc.client, uc.accountDb, uc.blockDb, uc.databaseDb, uc.eventDb, uc.failedHeightDb, uc.identityDb, uc.reportDb, uc.rewardDb, uc.slashDb, uc.syncableDb, uc.systemEventDb, uc.targetRangeDb, uc.transactionDb, uc.validatorDb)
 	if err != nil {
 		return err
 	}
@@ -15,35 +15,43 @@ type BackfillCmdHandler struct {
 
 	useCase *backfillUseCase
 
-	accountDb     store.Accounts
-	blockDb       store.Blocks
-	databaseDb    store.Database
-	eventDb       store.Events
-	reportDb      store.Reports
-	rewardDb      store.Rewards
-	syncableDb    store.Syncables
-	systemEventDb store.SystemEvents
-	transactionDb store.Transactions
-	validatorDb   store.Validators
+	accountDb      store.Accounts
+	blockDb        store.Blocks
+	databaseDb     store.Database
+	eventDb        store.Events
+	failedHeightDb store.FailedHeights
+	identityDb     store.Identities
+	reportDb       store.Reports
+	rewardDb       store.Rewards
+	slashDb        store.Slashes
+	syncableDb     store.Syncables
+	systemEventDb  store.SystemEvents
+	targetRangeDb  store.TargetRanges
+	transactionDb  store.Transactions
+	validatorDb    store.Validators
 }
 
-func NewBackfillCmdHandler(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, reportDb store.Reports,
-	rewardDb store.Rewards, syncableDb store.Syncables, systemEventDb store.SystemEvents, transactionDb store.Transactions, validatorDb store.Validators,
+func NewBackfillCmdHandler(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
 ) *BackfillCmdHandler {
 	return &BackfillCmdHandler{
 		cfg:    cfg,
 		client: cli,
 
-		accountDb:     accountDb,
-		blockDb:       blockDb,
-		databaseDb:    databaseDb,
-		eventDb:       eventDb,
-		reportDb:      reportDb,
-		rewardDb:      rewardDb,
-		syncableDb:    syncableDb,
-		systemEventDb: systemEventDb,
-		transactionDb: transactionDb,
-		validatorDb:   validatorDb,
+		accountDb:      accountDb,
+		blockDb:        blockDb,
+		databaseDb:     databaseDb,
+		eventDb:        eventDb,
+		failedHeightDb: failedHeightDb,
+		identityDb:     identityDb,
+		reportDb:       reportDb,
+		rewardDb:       rewardDb,
+		slashDb:        slashDb,
+		syncableDb:     syncableDb,
+		systemEventDb:  systemEventDb,
+		targetRangeDb:  targetRangeDb,
+		transactionDb:  transactionDb,
+		validatorDb:    validatorDb,
 	}
 }
 
@@ -64,7 +72,7 @@ func (h *BackfillCmdHandler) Handle(ctx context.Context, parallel bool, force bo
 
 func (h *BackfillCmdHandler) getUseCase() *backfillUseCase {
 	if h.useCase == nil {
-		return NewBackfillUseCase(h.cfg, h.client, h.accountDb, h.blockDb, h.databaseDb, h.eventDb, h.reportDb, h.rewardDb, h.syncableDb, h.systemEventDb, h.transactionDb, h.validatorDb)
+		return NewBackfillUseCase(h.cfg, h.client, h.accountDb, h.blockDb, h.databaseDb, h.eventDb, h.failedHeightDb, h.identityDb, h.reportDb, h.rewardDb, h.slashDb, h.syncableDb, h.systemEventDb, h.targetRangeDb, h.transactionDb, h.validatorDb)
 	}
 	return h.useCase
 }
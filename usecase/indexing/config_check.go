@@ -0,0 +1,20 @@
+package indexing
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/indexer"
+)
+
+type configCheckUseCase struct {
+	cfg *config.Config
+}
+
+func NewConfigCheckUseCase(cfg *config.Config) *configCheckUseCase {
+	return &configCheckUseCase{
+		cfg: cfg,
+	}
+}
+
+func (uc *configCheckUseCase) Execute() (*indexer.ConfigCheckResult, error) {
+	return indexer.CheckConfig(uc.cfg.IndexerConfigFile)
+}
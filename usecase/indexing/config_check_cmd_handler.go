@@ -0,0 +1,79 @@
+package indexing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/indexer"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+type ConfigCheckCmdHandler struct {
+	cfg *config.Config
+
+	useCase *configCheckUseCase
+}
+
+func NewConfigCheckCmdHandler(cfg *config.Config) *ConfigCheckCmdHandler {
+	return &ConfigCheckCmdHandler{
+		cfg: cfg,
+	}
+}
+
+func (h *ConfigCheckCmdHandler) Handle(ctx context.Context) {
+	logger.Info(fmt.Sprintf("checking indexer config use case [handler=cmd] [file=%s]", h.cfg.IndexerConfigFile))
+
+	result, err := h.getUseCase().Execute()
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	fmt.Println("=== Versions ===")
+	for _, v := range result.Versions {
+		fmt.Println(fmt.Sprintf("  - version %d: parallel=%t targets=%v", v.ID, v.Parallel, v.TargetIds))
+		printStageTasks(v.Stages)
+	}
+	fmt.Println("")
+
+	fmt.Println("=== Targets ===")
+	for _, t := range result.Targets {
+		fmt.Println(fmt.Sprintf("  - target %d %s:", t.ID, t.Name))
+		printStageTasks(t.Stages)
+	}
+	fmt.Println("")
+
+	fmt.Println("=== Problems ===")
+	for _, problem := range result.Problems {
+		fmt.Println("  -", problem)
+	}
+	fmt.Println("")
+
+	if !result.IsValid() {
+		logger.Error(errors.New(fmt.Sprintf("indexer config is invalid [problems=%d]", len(result.Problems))))
+		return
+	}
+	logger.Info("indexer config is valid")
+}
+
+func (h *ConfigCheckCmdHandler) getUseCase() *configCheckUseCase {
+	if h.useCase == nil {
+		return NewConfigCheckUseCase(h.cfg)
+	}
+	return h.useCase
+}
+
+func printStageTasks(stages []indexer.StageTasks) {
+	for _, stage := range stages {
+		fmt.Println(fmt.Sprintf("      %s:", stage.Stage))
+		for _, task := range stage.Tasks {
+			if len(task.Dependencies) == 0 {
+				fmt.Println(fmt.Sprintf("        %s", task.Name))
+				continue
+			}
+			fmt.Println(fmt.Sprintf("        %s <- %v", task.Name, task.Dependencies))
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package indexing
+
+import (
+	"context"
+
+	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/indexer"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+type reindexUseCase struct {
+	cfg    *config.Config
+	client *client.Client
+
+	accountDb      store.Accounts
+	blockDb        store.Blocks
+	databaseDb     store.Database
+	eventDb        store.Events
+	failedHeightDb store.FailedHeights
+	identityDb     store.Identities
+	reportDb       store.Reports
+	rewardDb       store.Rewards
+	slashDb        store.Slashes
+	syncableDb     store.Syncables
+	systemEventDb  store.SystemEvents
+	targetRangeDb  store.TargetRanges
+	transactionDb  store.Transactions
+	validatorDb    store.Validators
+}
+
+func NewReindexUseCase(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events,
+	failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports, rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
+) *reindexUseCase {
+	return &reindexUseCase{
+		cfg:    cfg,
+		client: cli,
+
+		accountDb:      accountDb,
+		blockDb:        blockDb,
+		databaseDb:     databaseDb,
+		eventDb:        eventDb,
+		failedHeightDb: failedHeightDb,
+		identityDb:     identityDb,
+		reportDb:       reportDb,
+		rewardDb:       rewardDb,
+		slashDb:        slashDb,
+		syncableDb:     syncableDb,
+		systemEventDb:  systemEventDb,
+		targetRangeDb:  targetRangeDb,
+		transactionDb:  transactionDb,
+		validatorDb:    validatorDb,
+	}
+}
+
+type ReindexUseCaseConfig struct {
+	StartHeight int64
+	EndHeight   int64
+	TargetIds   []int64
+}
+
+func (uc *reindexUseCase) Execute(ctx context.Context, useCaseConfig ReindexUseCaseConfig) error {
+	if err := uc.canExecute(); err != nil {
+		return err
+	}
+
+	indexingPipeline, err := indexer.NewPipeline(uc.cfg, uc.client, uc.accountDb, uc.blockDb, uc.databaseDb, uc.eventDb, uc.failedHeightDb, uc.identityDb, uc.reportDb, uc.rewardDb, uc.slashDb, uc.syncableDb, uc.systemEventDb, uc.targetRangeDb, uc.transactionDb, uc.validatorDb)
+	if err != nil {
+		return err
+	}
+
+	return indexingPipeline.Reindex(ctx, indexer.ReindexConfig{
+		StartHeight: useCaseConfig.StartHeight,
+		EndHeight:   useCaseConfig.EndHeight,
+		TargetIds:   useCaseConfig.TargetIds,
+	})
+}
+
+// canExecute checks if backfill is already running
+// if is it running we skip reindexing
+func (uc *reindexUseCase) canExecute() error {
+	if _, err := uc.reportDb.FindNotCompletedByKind(model.ReportKindSequentialReindex, model.ReportKindParallelReindex); err != nil {
+		if err == store.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	return ErrBackfillRunning
+}
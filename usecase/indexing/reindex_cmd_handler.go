@@ -0,0 +1,79 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+type ReindexCmdHandler struct {
+	cfg    *config.Config
+	client *client.Client
+
+	useCase *reindexUseCase
+
+	accountDb      store.Accounts
+	blockDb        store.Blocks
+	databaseDb     store.Database
+	eventDb        store.Events
+	failedHeightDb store.FailedHeights
+	identityDb     store.Identities
+	reportDb       store.Reports
+	rewardDb       store.Rewards
+	slashDb        store.Slashes
+	syncableDb     store.Syncables
+	systemEventDb  store.SystemEvents
+	targetRangeDb  store.TargetRanges
+	transactionDb  store.Transactions
+	validatorDb    store.Validators
+}
+
+func NewReindexCmdHandler(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
+) *ReindexCmdHandler {
+	return &ReindexCmdHandler{
+		cfg:    cfg,
+		client: cli,
+
+		accountDb:      accountDb,
+		blockDb:        blockDb,
+		databaseDb:     databaseDb,
+		eventDb:        eventDb,
+		failedHeightDb: failedHeightDb,
+		identityDb:     identityDb,
+		reportDb:       reportDb,
+		rewardDb:       rewardDb,
+		slashDb:        slashDb,
+		syncableDb:     syncableDb,
+		systemEventDb:  systemEventDb,
+		targetRangeDb:  targetRangeDb,
+		transactionDb:  transactionDb,
+		validatorDb:    validatorDb,
+	}
+}
+
+func (h *ReindexCmdHandler) Handle(ctx context.Context, startHeight int64, endHeight int64, targetIds []int64) {
+	logger.Info(fmt.Sprintf("running reindex use case [handler=cmd] [from=%d] [to=%d] [targetIds=%v]", startHeight, endHeight, targetIds))
+
+	useCaseConfig := ReindexUseCaseConfig{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		TargetIds:   targetIds,
+	}
+	err := h.getUseCase().Execute(ctx, useCaseConfig)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *ReindexCmdHandler) getUseCase() *reindexUseCase {
+	if h.useCase == nil {
+		return NewReindexUseCase(h.cfg, h.client, h.accountDb, h.blockDb, h.databaseDb, h.eventDb, h.failedHeightDb, h.identityDb, h.reportDb, h.rewardDb, h.slashDb, h.syncableDb, h.systemEventDb, h.targetRangeDb, h.transactionDb, h.validatorDb)
+	}
+	return h.useCase
+}
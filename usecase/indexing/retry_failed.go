@@ -0,0 +1,63 @@
+package indexing
+
+import (
+	"context"
+
+	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/indexer"
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+type retryFailedUseCase struct {
+	cfg    *config.Config
+	client *client.Client
+
+	accountDb      store.Accounts
+	blockDb        store.Blocks
+	databaseDb     store.Database
+	eventDb        store.Events
+	failedHeightDb store.FailedHeights
+	identityDb     store.Identities
+	reportDb       store.Reports
+	rewardDb       store.Rewards
+	slashDb        store.Slashes
+	syncableDb     store.Syncables
+	systemEventDb  store.SystemEvents
+	targetRangeDb  store.TargetRanges
+	transactionDb  store.Transactions
+	validatorDb    store.Validators
+}
+
+func NewRetryFailedUseCase(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
+) *retryFailedUseCase {
+	return &retryFailedUseCase{
+		cfg:    cfg,
+		client: cli,
+
+		accountDb:      accountDb,
+		blockDb:        blockDb,
+		databaseDb:     databaseDb,
+		eventDb:        eventDb,
+		failedHeightDb: failedHeightDb,
+		identityDb:     identityDb,
+		reportDb:       reportDb,
+		rewardDb:       rewardDb,
+		slashDb:        slashDb,
+		syncableDb:     syncableDb,
+		systemEventDb:  systemEventDb,
+		targetRangeDb:  targetRangeDb,
+		transactionDb:  transactionDb,
+		validatorDb:    validatorDb,
+	}
+}
+
+func (uc *retryFailedUseCase) Execute(ctx context.Context) error {
+	indexingPipeline, err := indexer.NewPipeline(uc.cfg, uc.client, uc.accountDb, uc.blockDb, uc.databaseDb, uc.eventDb, uc.failedHeightDb, uc.identityDb, uc.reportDb, uc.rewardDb, uc.slashDb, uc.syncableDb, uc.systemEventDb, uc.targetRangeDb, uc.transactionDb, uc.validatorDb)
+	if err != nil {
+		return err
+	}
+
+	return indexingPipeline.RetryFailed(ctx)
+}
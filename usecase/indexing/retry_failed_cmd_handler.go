@@ -0,0 +1,73 @@
+package indexing
+
+import (
+	"context"
+
+	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+type RetryFailedCmdHandler struct {
+	cfg    *config.Config
+	client *client.Client
+
+	useCase *retryFailedUseCase
+
+	accountDb      store.Accounts
+	blockDb        store.Blocks
+	databaseDb     store.Database
+	eventDb        store.Events
+	failedHeightDb store.FailedHeights
+	identityDb     store.Identities
+	reportDb       store.Reports
+	rewardDb       store.Rewards
+	slashDb        store.Slashes
+	syncableDb     store.Syncables
+	systemEventDb  store.SystemEvents
+	targetRangeDb  store.TargetRanges
+	transactionDb  store.Transactions
+	validatorDb    store.Validators
+}
+
+func NewRetryFailedCmdHandler(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
+) *RetryFailedCmdHandler {
+	return &RetryFailedCmdHandler{
+		cfg:    cfg,
+		client: cli,
+
+		accountDb:      accountDb,
+		blockDb:        blockDb,
+		databaseDb:     databaseDb,
+		eventDb:        eventDb,
+		failedHeightDb: failedHeightDb,
+		identityDb:     identityDb,
+		reportDb:       reportDb,
+		rewardDb:       rewardDb,
+		slashDb:        slashDb,
+		syncableDb:     syncableDb,
+		systemEventDb:  systemEventDb,
+		targetRangeDb:  targetRangeDb,
+		transactionDb:  transactionDb,
+		validatorDb:    validatorDb,
+	}
+}
+
+func (h *RetryFailedCmdHandler) Handle(ctx context.Context) {
+	logger.Info("running retry failed heights use case [handler=cmd]")
+
+	err := h.getUseCase().Execute(ctx)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *RetryFailedCmdHandler) getUseCase() *retryFailedUseCase {
+	if h.useCase == nil {
+		return NewRetryFailedUseCase(h.cfg, h.client, h.accountDb, h.blockDb, h.databaseDb, h.eventDb, h.failedHeightDb, h.identityDb, h.reportDb, h.rewardDb, h.slashDb, h.syncableDb, h.systemEventDb, h.targetRangeDb, h.transactionDb, h.validatorDb)
+	}
+	return h.useCase
+}
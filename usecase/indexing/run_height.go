@@ -0,0 +1,80 @@
+package indexing
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/indexer"
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+type runHeightUseCase struct {
+	cfg    *config.Config
+	client *client.Client
+
+	accountDb      store.Accounts
+	blockDb        store.Blocks
+	databaseDb     store.Database
+	eventDb        store.Events
+	failedHeightDb store.FailedHeights
+	identityDb     store.Identities
+	reportDb       store.Reports
+	rewardDb       store.Rewards
+	slashDb        store.Slashes
+	syncableDb     store.Syncables
+	systemEventDb  store.SystemEvents
+	targetRangeDb  store.TargetRanges
+	transactionDb  store.Transactions
+	validatorDb    store.Validators
+}
+
+func NewRunHeightUseCase(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events,
+	failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports, rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
+) *runHeightUseCase {
+	return &runHeightUseCase{
+		cfg:    cfg,
+		client: cli,
+
+		accountDb:      accountDb,
+		blockDb:        blockDb,
+		databaseDb:     databaseDb,
+		eventDb:        eventDb,
+		failedHeightDb: failedHeightDb,
+		identityDb:     identityDb,
+		reportDb:       reportDb,
+		rewardDb:       rewardDb,
+		slashDb:        slashDb,
+		syncableDb:     syncableDb,
+		systemEventDb:  systemEventDb,
+		targetRangeDb:  targetRangeDb,
+		transactionDb:  transactionDb,
+		validatorDb:    validatorDb,
+	}
+}
+
+type RunHeightUseCaseConfig struct {
+	Height    int64
+	TargetIds []int64
+	Dry       bool
+}
+
+// Execute runs pipeline for single height and returns resulting payload as JSON
+func (uc *runHeightUseCase) Execute(ctx context.Context, useCaseConfig RunHeightUseCaseConfig) ([]byte, error) {
+	indexingPipeline, err := indexer.NewPipeline(uc.cfg, uc.client, uc.accountDb, uc.blockDb, uc.databaseDb, uc.eventDb, uc.failedHeightDb, uc.identityDb, uc.reportDb, uc.rewardDb, uc.slashDb, uc.syncableDb, uc.systemEventDb, uc.targetRangeDb, uc.transactionDb, uc.validatorDb)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := indexingPipeline.Run(ctx, indexer.RunConfig{
+		Height:           useCaseConfig.Height,
+		DesiredTargetIDs: useCaseConfig.TargetIds,
+		Dry:              useCaseConfig.Dry,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(payload, "", "  ")
+}
@@ -0,0 +1,81 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+type RunHeightCmdHandler struct {
+	cfg    *config.Config
+	client *client.Client
+
+	useCase *runHeightUseCase
+
+	accountDb      store.Accounts
+	blockDb        store.Blocks
+	databaseDb     store.Database
+	eventDb        store.Events
+	failedHeightDb store.FailedHeights
+	identityDb     store.Identities
+	reportDb       store.Reports
+	rewardDb       store.Rewards
+	slashDb        store.Slashes
+	syncableDb     store.Syncables
+	systemEventDb  store.SystemEvents
+	targetRangeDb  store.TargetRanges
+	transactionDb  store.Transactions
+	validatorDb    store.Validators
+}
+
+func NewRunHeightCmdHandler(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
+) *RunHeightCmdHandler {
+	return &RunHeightCmdHandler{
+		cfg:    cfg,
+		client: cli,
+
+		accountDb:      accountDb,
+		blockDb:        blockDb,
+		databaseDb:     databaseDb,
+		eventDb:        eventDb,
+		failedHeightDb: failedHeightDb,
+		identityDb:     identityDb,
+		reportDb:       reportDb,
+		rewardDb:       rewardDb,
+		slashDb:        slashDb,
+		syncableDb:     syncableDb,
+		systemEventDb:  systemEventDb,
+		targetRangeDb:  targetRangeDb,
+		transactionDb:  transactionDb,
+		validatorDb:    validatorDb,
+	}
+}
+
+func (h *RunHeightCmdHandler) Handle(ctx context.Context, height int64, targetIds []int64, dry bool) {
+	logger.Info(fmt.Sprintf("running run height use case [handler=cmd] [height=%d] [targetIds=%v] [dry=%t]", height, targetIds, dry))
+
+	useCaseConfig := RunHeightUseCaseConfig{
+		Height:    height,
+		TargetIds: targetIds,
+		Dry:       dry,
+	}
+	dump, err := h.getUseCase().Execute(ctx, useCaseConfig)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	fmt.Println(string(dump))
+}
+
+func (h *RunHeightCmdHandler) getUseCase() *runHeightUseCase {
+	if h.useCase == nil {
+		return NewRunHeightUseCase(h.cfg, h.client, h.accountDb, h.blockDb, h.databaseDb, h.eventDb, h.failedHeightDb, h.identityDb, h.reportDb, h.rewardDb, h.slashDb, h.syncableDb, h.systemEventDb, h.targetRangeDb, h.transactionDb, h.validatorDb)
+	}
+	return h.useCase
+}
@@ -19,50 +19,59 @@ type startUseCase struct {
 	cfg    *config.Config
 	client *client.Client
 
-	accountDb     store.Accounts
-	blockDb       store.Blocks
-	databaseDb    store.Database
-	eventDb       store.Events
-	reportDb      store.Reports
-	rewardDb      store.Rewards
-	syncableDb    store.Syncables
-	systemEventDb store.SystemEvents
-	transactionDb store.Transactions
-	validatorDb   store.Validators
+	accountDb      store.Accounts
+	blockDb        store.Blocks
+	databaseDb     store.Database
+	eventDb        store.Events
+	failedHeightDb store.FailedHeights
+	identityDb     store.Identities
+	reportDb       store.Reports
+	rewardDb       store.Rewards
+	slashDb        store.Slashes
+	syncableDb     store.Syncables
+	systemEventDb  store.SystemEvents
+	targetRangeDb  store.TargetRanges
+	transactionDb  store.Transactions
+	validatorDb    store.Validators
 }
 
-func NewStartUseCase(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, reportDb store.Reports,
-	rewardDb store.Rewards, syncableDb store.Syncables, systemEventDb store.SystemEvents, transactionDb store.Transactions, validatorDb store.Validators,
+func NewStartUseCase(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
 ) *startUseCase {
 	return &startUseCase{
 		cfg:    cfg,
 		client: cli,
 
-		accountDb:     accountDb,
-		blockDb:       blockDb,
-		databaseDb:    databaseDb,
-		eventDb:       eventDb,
-		reportDb:      reportDb,
-		rewardDb:      rewardDb,
-		syncableDb:    syncableDb,
-		systemEventDb: systemEventDb,
-		transactionDb: transactionDb,
-		validatorDb:   validatorDb,
+		accountDb:      accountDb,
+		blockDb:        blockDb,
+		databaseDb:     databaseDb,
+		eventDb:        eventDb,
+		failedHeightDb: failedHeightDb,
+		identityDb:     identityDb,
+		reportDb:       reportDb,
+		rewardDb:       rewardDb,
+		slashDb:        slashDb,
+		syncableDb:     syncableDb,
+		systemEventDb:  systemEventDb,
+		targetRangeDb:  targetRangeDb,
+		transactionDb:  transactionDb,
+		validatorDb:    validatorDb,
 	}
 }
 
-func (uc *startUseCase) Execute(ctx context.Context, batchSize int64) error {
+func (uc *startUseCase) Execute(ctx context.Context, batchSize int64, skipFailed bool) error {
 	if err := uc.canExecute(); err != nil {
 		return err
 	}
 
-	indexingPipeline, err := indexer.NewPipeline(uc.cfg, uc.client, uc.accountDb, uc.blockDb, uc.databaseDb, uc.eventDb, uc.reportDb, uc.rewardDb, uc.syncableDb, uc.systemEventDb, uc.transactionDb, uc.validatorDb)
+	indexingPipeline, err := indexer.NewPipeline(uc.cfg, uc.client, uc.accountDb, uc.blockDb, uc.databaseDb, uc.eventDb, uc.failedHeightDb, uc.identityDb, uc.reportDb, uc.rewardDb, uc.slashDb, uc.syncableDb, uc.systemEventDb, uc.targetRangeDb, uc.transactionDb, uc.validatorDb)
 	if err != nil {
 		return err
 	}
 
 	return indexingPipeline.Start(ctx, indexer.IndexConfig{
-		BatchSize: batchSize,
+		BatchSize:  batchSize,
+		SkipFailed: skipFailed,
 	})
 }
 
@@ -21,45 +21,54 @@ type runWorkerHandler struct {
 
 	useCase *startUseCase
 
-	accountDb     store.Accounts
-	blockDb       store.Blocks
-	databaseDb    store.Database
-	eventDb       store.Events
-	reportDb      store.Reports
-	rewardDb      store.Rewards
-	syncableDb    store.Syncables
-	systemEventDb store.SystemEvents
-	transactionDb store.Transactions
-	validatorDb   store.Validators
+	accountDb      store.Accounts
+	blockDb        store.Blocks
+	databaseDb     store.Database
+	eventDb        store.Events
+	failedHeightDb store.FailedHeights
+	identityDb     store.Identities
+	reportDb       store.Reports
+	rewardDb       store.Rewards
+	slashDb        store.Slashes
+	syncableDb     store.Syncables
+	systemEventDb  store.SystemEvents
+	targetRangeDb  store.TargetRanges
+	transactionDb  store.Transactions
+	validatorDb    store.Validators
 }
 
-func NewRunWorkerHandler(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, reportDb store.Reports,
-	rewardDb store.Rewards, syncableDb store.Syncables, systemEventDb store.SystemEvents, transactionDb store.Transactions, validatorDb store.Validators,
+func NewRunWorkerHandler(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
 ) *runWorkerHandler {
 	return &runWorkerHandler{
 		cfg:    cfg,
 		client: cli,
 
-		accountDb:     accountDb,
-		blockDb:       blockDb,
-		databaseDb:    databaseDb,
-		eventDb:       eventDb,
-		reportDb:      reportDb,
-		rewardDb:      rewardDb,
-		syncableDb:    syncableDb,
-		systemEventDb: systemEventDb,
-		transactionDb: transactionDb,
-		validatorDb:   validatorDb,
+		accountDb:      accountDb,
+		blockDb:        blockDb,
+		databaseDb:     databaseDb,
+		eventDb:        eventDb,
+		failedHeightDb: failedHeightDb,
+		identityDb:     identityDb,
+		reportDb:       reportDb,
+		rewardDb:       rewardDb,
+		slashDb:        slashDb,
+		syncableDb:     syncableDb,
+		systemEventDb:  systemEventDb,
+		targetRangeDb:  targetRangeDb,
+		transactionDb:  transactionDb,
+		validatorDb:    validatorDb,
 	}
 }
 
 func (h *runWorkerHandler) Handle() {
 	batchSize := h.cfg.DefaultBatchSize
+	skipFailed := h.cfg.SkipFailedHeights
 	ctx := context.Background()
 
-	logger.Info(fmt.Sprintf("running indexer use case [handler=worker] [batchSize=%d]", batchSize))
+	logger.Info(fmt.Sprintf("running indexer use case [handler=worker] [batchSize=%d] [skipFailed=%t]", batchSize, skipFailed))
 
-	err := h.getUseCase().Execute(ctx, batchSize)
+	err := h.getUseCase().Execute(ctx, batchSize, skipFailed)
 	if err != nil {
 		logger.Error(err)
 		return
@@ -68,7 +77,7 @@ func (h *runWorkerHandler) Handle() {
 
 func (h *runWorkerHandler) getUseCase() *startUseCase {
 	if h.useCase == nil {
-		return NewStartUseCase(h.cfg, h.client, h.accountDb, h.blockDb, h.databaseDb, h.eventDb, h.reportDb, h.rewardDb, h.syncableDb, h.systemEventDb, h.transactionDb, h.validatorDb)
+		return NewStartUseCase(h.cfg, h.client, h.accountDb, h.blockDb, h.databaseDb, h.eventDb, h.failedHeightDb, h.identityDb, h.reportDb, h.rewardDb, h.slashDb, h.syncableDb, h.systemEventDb, h.targetRangeDb, h.transactionDb, h.validatorDb)
 	}
 	return h.useCase
 }
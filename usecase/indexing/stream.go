@@ -0,0 +1,112 @@
+package indexing
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/indexer"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+var (
+	ErrInvalidStreamInterval = errors.New("stream poll interval and max backoff must be greater than 0")
+)
+
+type streamUseCase struct {
+	cfg    *config.Config
+	client *client.Client
+
+	accountDb      store.Accounts
+	blockDb        store.Blocks
+	databaseDb     store.Database
+	eventDb        store.Events
+	failedHeightDb store.FailedHeights
+	identityDb     store.Identities
+	reportDb       store.Reports
+	rewardDb       store.Rewards
+	slashDb        store.Slashes
+	syncableDb     store.Syncables
+	systemEventDb  store.SystemEvents
+	targetRangeDb  store.TargetRanges
+	transactionDb  store.Transactions
+	validatorDb    store.Validators
+}
+
+func NewStreamUseCase(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
+) *streamUseCase {
+	return &streamUseCase{
+		cfg:    cfg,
+		client: cli,
+
+		accountDb:      accountDb,
+		blockDb:        blockDb,
+		databaseDb:     databaseDb,
+		eventDb:        eventDb,
+		failedHeightDb: failedHeightDb,
+		identityDb:     identityDb,
+		reportDb:       reportDb,
+		rewardDb:       rewardDb,
+		slashDb:        slashDb,
+		syncableDb:     syncableDb,
+		systemEventDb:  systemEventDb,
+		targetRangeDb:  targetRangeDb,
+		transactionDb:  transactionDb,
+		validatorDb:    validatorDb,
+	}
+}
+
+func (uc *streamUseCase) Execute(ctx context.Context, skipFailed bool) error {
+	if err := uc.canExecute(); err != nil {
+		return err
+	}
+
+	pollInterval, err := uc.parseDuration(uc.cfg.StreamPollInterval)
+	if err != nil {
+		return err
+	}
+
+	maxBackoff, err := uc.parseDuration(uc.cfg.StreamMaxBackoff)
+	if err != nil {
+		return err
+	}
+
+	indexingPipeline, err := indexer.NewPipeline(uc.cfg, uc.client, uc.accountDb, uc.blockDb, uc.databaseDb, uc.eventDb, uc.failedHeightDb, uc.identityDb, uc.reportDb, uc.rewardDb, uc.slashDb, uc.syncableDb, uc.systemEventDb, uc.targetRangeDb, uc.transactionDb, uc.validatorDb)
+	if err != nil {
+		return err
+	}
+
+	return indexingPipeline.Stream(ctx, indexer.StreamConfig{
+		PollInterval: pollInterval,
+		MaxBackoff:   maxBackoff,
+		SkipFailed:   skipFailed,
+	})
+}
+
+func (uc *streamUseCase) parseDuration(interval string) (time.Duration, error) {
+	duration, err := time.ParseDuration(interval)
+	if err != nil {
+		return 0, err
+	}
+
+	if duration <= 0 {
+		return 0, ErrInvalidStreamInterval
+	}
+	return duration, nil
+}
+
+// canExecute checks if sequential reindex is already running
+// if is it running we skip streaming
+func (uc *streamUseCase) canExecute() error {
+	if _, err := uc.reportDb.FindNotCompletedByKind(model.ReportKindSequentialReindex); err != nil {
+		if err == store.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	return ErrRunningSequentialReindex
+}
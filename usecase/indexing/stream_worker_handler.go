@@ -0,0 +1,82 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/polkadothub-indexer/client"
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+var (
+	_ types.WorkerHandler = (*streamWorkerHandler)(nil)
+)
+
+type streamWorkerHandler struct {
+	cfg    *config.Config
+	client *client.Client
+
+	useCase *streamUseCase
+
+	accountDb      store.Accounts
+	blockDb        store.Blocks
+	databaseDb     store.Database
+	eventDb        store.Events
+	failedHeightDb store.FailedHeights
+	identityDb     store.Identities
+	reportDb       store.Reports
+	rewardDb       store.Rewards
+	slashDb        store.Slashes
+	syncableDb     store.Syncables
+	systemEventDb  store.SystemEvents
+	targetRangeDb  store.TargetRanges
+	transactionDb  store.Transactions
+	validatorDb    store.Validators
+}
+
+func NewStreamWorkerHandler(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators,
+) *streamWorkerHandler {
+	return &streamWorkerHandler{
+		cfg:    cfg,
+		client: cli,
+
+		accountDb:      accountDb,
+		blockDb:        blockDb,
+		databaseDb:     databaseDb,
+		eventDb:        eventDb,
+		failedHeightDb: failedHeightDb,
+		identityDb:     identityDb,
+		reportDb:       reportDb,
+		rewardDb:       rewardDb,
+		slashDb:        slashDb,
+		syncableDb:     syncableDb,
+		systemEventDb:  systemEventDb,
+		targetRangeDb:  targetRangeDb,
+		transactionDb:  transactionDb,
+		validatorDb:    validatorDb,
+	}
+}
+
+func (h *streamWorkerHandler) Handle() {
+	skipFailed := h.cfg.SkipFailedHeights
+	ctx := context.Background()
+
+	logger.Info(fmt.Sprintf("running stream indexer use case [handler=worker] [skipFailed=%t]", skipFailed))
+
+	err := h.getUseCase().Execute(ctx, skipFailed)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *streamWorkerHandler) getUseCase() *streamUseCase {
+	if h.useCase == nil {
+		return NewStreamUseCase(h.cfg, h.client, h.accountDb, h.blockDb, h.databaseDb, h.eventDb, h.failedHeightDb, h.identityDb, h.reportDb, h.rewardDb, h.slashDb, h.syncableDb, h.systemEventDb, h.targetRangeDb, h.transactionDb, h.validatorDb)
+	}
+	return h.useCase
+}
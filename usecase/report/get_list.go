@@ -0,0 +1,30 @@
+package report
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+const defaultListLimit = 20
+
+type getListUseCase struct {
+	reportDb store.Reports
+}
+
+func NewGetListUseCase(reportDb store.Reports) *getListUseCase {
+	return &getListUseCase{
+		reportDb: reportDb,
+	}
+}
+
+func (uc *getListUseCase) Execute(limit int64) (*ListView, error) {
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+
+	reports, err := uc.reportDb.FindRecent(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToListView(reports), nil
+}
@@ -0,0 +1,67 @@
+package report
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+type GetListCmdHandler struct {
+	useCase *getListUseCase
+
+	reportDb store.Reports
+}
+
+func NewGetListCmdHandler(reportDb store.Reports) *GetListCmdHandler {
+	return &GetListCmdHandler{
+		reportDb: reportDb,
+	}
+}
+
+func (h *GetListCmdHandler) Handle(ctx context.Context, limit int64) {
+	logger.Info("report get list use case [handler=cmd]")
+
+	list, err := h.getUseCase().Execute(limit)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	fmt.Println("=== Reports ===")
+	for _, item := range list.Items {
+		fmt.Println(fmt.Sprintf("  - %d %s: start=%d end=%d running=%t", item.ID, item.Kind, item.StartHeight, item.EndHeight, item.Running))
+		if item.LastHeight != nil {
+			fmt.Println(fmt.Sprintf("    last height=%d heights/s=%.2f eta=%v", *item.LastHeight, valueOf(item.HeightsPerSecond), item.EstimatedCompletedAt))
+		}
+		if item.CompletedAt != nil {
+			fmt.Println(fmt.Sprintf("    completed at=%v success=%d errors=%d", item.CompletedAt, valueOfInt(item.SuccessCount), valueOfInt(item.ErrorCount)))
+		}
+		if item.ErrorMsg != nil {
+			fmt.Println("    error:", *item.ErrorMsg)
+		}
+	}
+	fmt.Println("")
+}
+
+func (h *GetListCmdHandler) getUseCase() *getListUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetListUseCase(h.reportDb)
+	}
+	return h.useCase
+}
+
+func valueOf(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func valueOfInt(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
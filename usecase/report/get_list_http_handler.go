@@ -0,0 +1,57 @@
+package report
+
+import (
+	"errors"
+
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/usecase/http"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*getListHttpHandler)(nil)
+)
+
+type getListHttpHandler struct {
+	useCase *getListUseCase
+
+	reportDb store.Reports
+}
+
+func NewGetListHttpHandler(reportDb store.Reports) *getListHttpHandler {
+	return &getListHttpHandler{
+		reportDb: reportDb,
+	}
+}
+
+type GetListRequest struct {
+	Limit int64 `form:"limit" binding:"-"`
+}
+
+func (h *getListHttpHandler) Handle(c *gin.Context) {
+	var req GetListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid limit"))
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Limit)
+	if err != nil {
+		logger.Error(err)
+		http.ServerError(c, err)
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getListHttpHandler) getUseCase() *getListUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetListUseCase(h.reportDb)
+	}
+	return h.useCase
+}
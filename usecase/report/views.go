@@ -0,0 +1,55 @@
+package report
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/types"
+)
+
+type ListItem struct {
+	*model.Model
+
+	Kind                 string      `json:"kind"`
+	IndexVersion         int64       `json:"index_version"`
+	StartHeight          int64       `json:"start_height"`
+	EndHeight            int64       `json:"end_height"`
+	LastHeight           *int64      `json:"last_height"`
+	SuccessCount         *int64      `json:"success_count"`
+	ErrorCount           *int64      `json:"error_count"`
+	ErrorMsg             *string     `json:"error_msg"`
+	HeightsPerSecond     *float64    `json:"heights_per_second"`
+	EstimatedCompletedAt *types.Time `json:"estimated_completed_at"`
+	CheckpointedAt       *types.Time `json:"checkpointed_at"`
+	CompletedAt          *types.Time `json:"completed_at"`
+	Running              bool        `json:"running"`
+}
+
+type ListView struct {
+	Items []ListItem `json:"items"`
+}
+
+func ToListView(reports []model.Report) *ListView {
+	items := make([]ListItem, len(reports))
+	for i, m := range reports {
+		items[i] = ListItem{
+			Model: m.Model,
+
+			Kind:                 m.Kind.String(),
+			IndexVersion:         m.IndexVersion,
+			StartHeight:          m.StartHeight,
+			EndHeight:            m.EndHeight,
+			LastHeight:           m.LastHeight,
+			SuccessCount:         m.SuccessCount,
+			ErrorCount:           m.ErrorCount,
+			ErrorMsg:             m.ErrorMsg,
+			HeightsPerSecond:     m.HeightsPerSecond,
+			EstimatedCompletedAt: m.EstimatedCompletedAt,
+			CheckpointedAt:       m.CheckpointedAt,
+			CompletedAt:          m.CompletedAt,
+			Running:              !m.IsCompleted(),
+		}
+	}
+
+	return &ListView{
+		Items: items,
+	}
+}
@@ -0,0 +1,202 @@
+package reward
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+const (
+	ExportFormatCSV    ExportFormat = "csv"
+	ExportFormatNDJSON ExportFormat = "ndjson"
+
+	// exportFlushRows is number of rows after which written rows are flushed to client
+	exportFlushRows = 500
+)
+
+var (
+	ErrInvalidExportFormat   = errors.New("format must be csv or ndjson")
+	ErrInvalidExportDecimals = errors.New("decimals must be between 0 and 30")
+
+	exportColumns = []string{"era", "era_end_time", "stash_account", "validator_stash_account", "kind", "claimed", "amount_planck", "amount_dot"}
+)
+
+type ExportFormat string
+
+func (f ExportFormat) String() string {
+	return string(f)
+}
+
+func (f ExportFormat) Valid() bool {
+	return f == ExportFormatCSV || f == ExportFormatNDJSON
+}
+
+// ContentType returns MIME type of exported rows
+func (f ExportFormat) ContentType() string {
+	if f == ExportFormatNDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv"
+}
+
+// ExportRow is single exported reward
+type ExportRow struct {
+	Era                   int64  `json:"era"`
+	EraEndTime            string `json:"era_end_time"`
+	StashAccount          string `json:"stash_account"`
+	ValidatorStashAccount string `json:"validator_stash_account"`
+	Kind                  string `json:"kind"`
+	Claimed               bool   `json:"claimed"`
+	AmountPlanck          string `json:"amount_planck"`
+	AmountDot             string `json:"amount_dot"`
+}
+
+func (r ExportRow) record() []string {
+	return []string{strconv.FormatInt(r.Era, 10), r.EraEndTime, r.StashAccount, r.ValidatorStashAccount, r.Kind, strconv.FormatBool(r.Claimed), r.AmountPlanck, r.AmountDot}
+}
+
+type flusher interface {
+	Flush()
+}
+
+type exportUseCase struct {
+	cfg *config.Config
+
+	rewardDb store.Rewards
+}
+
+func NewExportUseCase(cfg *config.Config, rewardDb store.Rewards) *exportUseCase {
+	return &exportUseCase{
+		cfg: cfg,
+
+		rewardDb: rewardDb,
+	}
+}
+
+// Validate checks export params before anything is written, decimals < 0 fall back to configured decimals
+func (uc *exportUseCase) Validate(format ExportFormat, decimals int64) error {
+	if !format.Valid() {
+		return ErrInvalidExportFormat
+	}
+	if decimals > 30 {
+		return ErrInvalidExportDecimals
+	}
+	return nil
+}
+
+// Execute streams rewards of stash to w row by row. Rows are flushed periodically when w supports flushing
+func (uc *exportUseCase) Execute(w io.Writer, stash string, start, end int64, format ExportFormat, decimals int64) error {
+	if err := uc.Validate(format, decimals); err != nil {
+		return err
+	}
+	if decimals < 0 {
+		decimals = uc.cfg.RewardsExportDecimals
+	}
+
+	var csvWriter *csv.Writer
+	var jsonEncoder *json.Encoder
+
+	if format == ExportFormatCSV {
+		csvWriter = csv.NewWriter(w)
+		if err := csvWriter.Write(exportColumns); err != nil {
+			return err
+		}
+	} else {
+		jsonEncoder = json.NewEncoder(w)
+	}
+
+	var count int64
+	flush := func() error {
+		if csvWriter != nil {
+			csvWriter.Flush()
+			if err := csvWriter.Error(); err != nil {
+				return err
+			}
+		}
+		if f, ok := w.(flusher); ok {
+			f.Flush()
+		}
+		return nil
+	}
+
+	err := uc.rewardDb.StreamAll(stash, start, end, func(reward model.RewardEraSeq) error {
+		row, err := toExportRow(reward, decimals)
+		if err != nil {
+			return err
+		}
+
+		if csvWriter != nil {
+			err = csvWriter.Write(row.record())
+		} else {
+			err = jsonEncoder.Encode(row)
+		}
+		if err != nil {
+			return err
+		}
+
+		count++
+		if count%exportFlushRows == 0 {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return flush()
+}
+
+func toExportRow(reward model.RewardEraSeq, decimals int64) (ExportRow, error) {
+	amountDot, err := formatAmount(reward.Amount, decimals)
+	if err != nil {
+		return ExportRow{}, err
+	}
+
+	return ExportRow{
+		Era:                   reward.Era,
+		EraEndTime:            reward.Time.UTC().Format(time.RFC3339),
+		StashAccount:          reward.StashAccount,
+		ValidatorStashAccount: reward.ValidatorStashAccount,
+		Kind:                  reward.Kind.String(),
+		Claimed:               reward.Claimed,
+		AmountPlanck:          reward.Amount,
+		AmountDot:             amountDot,
+	}, nil
+}
+
+// formatAmount formats amount in Planck as decimal number with given number of decimals, ie. 12345 with 4 decimals is 1.2345
+func formatAmount(amount string, decimals int64) (string, error) {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return "", errors.New(fmt.Sprintf("invalid reward amount %s", amount))
+	}
+
+	sign := ""
+	if value.Sign() < 0 {
+		sign = "-"
+		value.Abs(value)
+	}
+
+	digits := value.String()
+	if decimals == 0 {
+		return sign + digits, nil
+	}
+
+	if int64(len(digits)) <= decimals {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+
+	split := int64(len(digits)) - decimals
+	return fmt.Sprintf("%s%s.%s", sign, digits[:split], digits[split:]), nil
+}
@@ -0,0 +1,71 @@
+package reward
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+type ExportCmdHandler struct {
+	cfg      *config.Config
+	rewardDb store.Rewards
+
+	useCase *exportUseCase
+}
+
+func NewExportCmdHandler(cfg *config.Config, rewardDb store.Rewards) *ExportCmdHandler {
+	return &ExportCmdHandler{
+		cfg:      cfg,
+		rewardDb: rewardDb,
+	}
+}
+
+// Handle exports rewards of stash to output file, or to stdout when output is empty
+func (h *ExportCmdHandler) Handle(ctx context.Context, stash string, start, end int64, format string, decimals int64, output string) {
+	logger.Info(fmt.Sprintf("running reward export use case [handler=cmd] [stash=%s] [format=%s]", stash, format))
+
+	if stash == "" {
+		logger.Error(fmt.Errorf("stash_account is required"))
+		return
+	}
+
+	if format == "" {
+		format = ExportFormatCSV.String()
+	}
+
+	if err := h.getUseCase().Validate(ExportFormat(format), decimals); err != nil {
+		logger.Error(err)
+		return
+	}
+
+	w := os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			logger.Error(err)
+			return
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := h.getUseCase().Execute(w, stash, start, end, ExportFormat(format), decimals); err != nil {
+		logger.Error(err)
+		return
+	}
+
+	if output != "" {
+		fmt.Println(fmt.Sprintf("rewards of %s exported to %s", stash, output))
+	}
+}
+
+func (h *ExportCmdHandler) getUseCase() *exportUseCase {
+	if h.useCase == nil {
+		h.useCase = NewExportUseCase(h.cfg, h.rewardDb)
+	}
+	return h.useCase
+}
@@ -0,0 +1,82 @@
+package reward
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/usecase/http"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*exportHttpHandler)(nil)
+)
+
+type exportHttpHandler struct {
+	cfg      *config.Config
+	rewardDb store.Rewards
+
+	useCase *exportUseCase
+}
+
+func NewExportHttpHandler(cfg *config.Config, rewardDb store.Rewards) *exportHttpHandler {
+	return &exportHttpHandler{
+		cfg:      cfg,
+		rewardDb: rewardDb,
+	}
+}
+
+type ExportRequest struct {
+	StashAccount string       `uri:"stash_account" binding:"required"`
+	Start        int64        `form:"start" binding:"-"`
+	End          int64        `form:"end" binding:"-"`
+	Format       ExportFormat `form:"format" binding:"-"`
+	Decimals     *int64       `form:"decimals" binding:"-"`
+}
+
+func (h *exportHttpHandler) Handle(c *gin.Context) {
+	var req ExportRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid stash account"))
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid start, end, format or/and decimals"))
+		return
+	}
+
+	if req.Format == "" {
+		req.Format = ExportFormatCSV
+	}
+	decimals := int64(-1)
+	if req.Decimals != nil {
+		decimals = *req.Decimals
+	}
+
+	if err := h.getUseCase().Validate(req.Format, decimals); err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	c.Header("Content-Type", req.Format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=rewards-%s.%s", req.StashAccount, req.Format))
+
+	// Headers are already sent when rows are streamed, so error can only be logged
+	if err := h.getUseCase().Execute(c.Writer, req.StashAccount, req.Start, req.End, req.Format, decimals); err != nil {
+		logger.Error(err)
+	}
+}
+
+func (h *exportHttpHandler) getUseCase() *exportUseCase {
+	if h.useCase == nil {
+		h.useCase = NewExportUseCase(h.cfg, h.rewardDb)
+	}
+	return h.useCase
+}
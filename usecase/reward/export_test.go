@@ -0,0 +1,46 @@
+package reward
+
+import (
+	"testing"
+)
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		description string
+		amount      string
+		decimals    int64
+		expect      string
+		expectErr   bool
+	}{
+		{"splits amount by decimals", "12345", 4, "1.2345", false},
+		{"pads amount shorter than decimals", "123", 5, "0.00123", false},
+		{"pads amount as long as decimals", "12345", 5, "0.12345", false},
+		{"keeps trailing zeros", "10000000000", 10, "1.0000000000", false},
+		{"formats zero", "0", 3, "0.000", false},
+		{"does not split amount without decimals", "12345", 0, "12345", false},
+		{"formats negative amount", "-123", 4, "-0.0123", false},
+		{"formats amount bigger than int64", "123456789012345678901234567890", 10, "12345678901234567890.1234567890", false},
+		{"returns error for invalid amount", "12.5", 4, "", true},
+		{"returns error for empty amount", "", 4, "", true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			got, err := formatAmount(tt.amount, tt.decimals)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("expected error, got: %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if got != tt.expect {
+				t.Errorf("unexpected amount, want: %s; got: %s", tt.expect, got)
+			}
+		})
+	}
+}
@@ -1,10 +1,18 @@
 package reward
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/figment-networks/polkadothub-indexer/model"
 	"github.com/figment-networks/polkadothub-indexer/store"
 )
 
+const (
+	defaultSummaryLimit int64 = 20
+	maxSummaryLimit     int64 = 100
+)
+
 type getForStashAccountUseCase struct {
 	rewardDb store.Rewards
 }
@@ -23,3 +31,31 @@ func (uc *getForStashAccountUseCase) Execute(stash string, start, end int64) ([]
 
 	return rewards, nil
 }
+
+// ExecuteSummary returns page of reward totals of stash grouped by era, day, month or validator with grand total of all groups
+func (uc *getForStashAccountUseCase) ExecuteSummary(stash string, start, end int64, groupBy model.RewardGroupBy, page, limit int64) (*SummaryView, error) {
+	if !groupBy.Valid() {
+		return nil, errors.New(fmt.Sprintf("group_by must be one of %s, %s, %s, %s", model.RewardGroupByEra, model.RewardGroupByDay, model.RewardGroupByMonth, model.RewardGroupByValidator))
+	}
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = defaultSummaryLimit
+	}
+	if limit > maxSummaryLimit {
+		limit = maxSummaryLimit
+	}
+
+	summaries, err := uc.rewardDb.GetSummaries(stash, start, end, groupBy, limit, (page-1)*limit)
+	if err != nil {
+		return nil, err
+	}
+
+	total, groupsCount, err := uc.rewardDb.GetSummariesTotal(stash, start, end, groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToSummaryView(summaries, total, groupBy, page, limit, groupsCount), nil
+}
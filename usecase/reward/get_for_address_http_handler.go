@@ -3,6 +3,7 @@ package reward
 import (
 	"errors"
 
+	"github.com/figment-networks/polkadothub-indexer/model"
 	"github.com/figment-networks/polkadothub-indexer/store"
 	"github.com/figment-networks/polkadothub-indexer/types"
 	"github.com/figment-networks/polkadothub-indexer/usecase/http"
@@ -28,9 +29,12 @@ func NewGetForStashAccountHttpHandler(rewardDb store.Rewards) *getForStashAccoun
 }
 
 type GetForStashAccountRequest struct {
-	StashAccount string `uri:"stash_account" binding:"required"`
-	Start        int64  `form:"start" binding:"-"`
-	End          int64  `form:"end" binding:"-"`
+	StashAccount string              `uri:"stash_account" binding:"required"`
+	Start        int64               `form:"start" binding:"-"`
+	End          int64               `form:"end" binding:"-"`
+	GroupBy      model.RewardGroupBy `form:"group_by" binding:"-"`
+	Page         int64               `form:"page" binding:"-"`
+	Limit        int64               `form:"limit" binding:"-"`
 }
 
 func (h *getForStashAccountHttpHandler) Handle(c *gin.Context) {
@@ -42,7 +46,12 @@ func (h *getForStashAccountHttpHandler) Handle(c *gin.Context) {
 	}
 	if err := c.ShouldBindQuery(&req); err != nil {
 		logger.Error(err)
-		http.BadRequest(c, errors.New("invalid start or/and end"))
+		http.BadRequest(c, errors.New("invalid start, end, group_by, page or/and limit"))
+		return
+	}
+
+	if req.GroupBy != "" {
+		h.handleSummary(c, req)
 		return
 	}
 
@@ -56,6 +65,22 @@ func (h *getForStashAccountHttpHandler) Handle(c *gin.Context) {
 	http.JsonOK(c, resp)
 }
 
+func (h *getForStashAccountHttpHandler) handleSummary(c *gin.Context, req GetForStashAccountRequest) {
+	if !req.GroupBy.Valid() {
+		http.BadRequest(c, errors.New("invalid group_by"))
+		return
+	}
+
+	resp, err := h.getUseCase().ExecuteSummary(req.StashAccount, req.Start, req.End, req.GroupBy, req.Page, req.Limit)
+	if err != nil {
+		logger.Error(err)
+		http.ServerError(c, err)
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
 func (h *getForStashAccountHttpHandler) getUseCase() *getForStashAccountUseCase {
 	if h.useCase == nil {
 		h.useCase = NewGetForStashAccountUseCase(h.rewardDb)
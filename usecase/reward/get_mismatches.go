@@ -0,0 +1,24 @@
+package reward
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+type getMismatchesUseCase struct {
+	rewardDb store.Rewards
+}
+
+func NewGetMismatchesUseCase(rewardDb store.Rewards) *getMismatchesUseCase {
+	return &getMismatchesUseCase{
+		rewardDb: rewardDb,
+	}
+}
+
+func (uc *getMismatchesUseCase) Execute(validatorStash string, start, end int64) (*MismatchesView, error) {
+	discrepancies, err := uc.rewardDb.FindDiscrepancies(validatorStash, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToMismatchesView(discrepancies), nil
+}
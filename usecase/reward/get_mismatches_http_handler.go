@@ -0,0 +1,59 @@
+package reward
+
+import (
+	"errors"
+
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/usecase/http"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*getMismatchesHttpHandler)(nil)
+)
+
+type getMismatchesHttpHandler struct {
+	rewardDb store.Rewards
+
+	useCase *getMismatchesUseCase
+}
+
+func NewGetMismatchesHttpHandler(rewardDb store.Rewards) *getMismatchesHttpHandler {
+	return &getMismatchesHttpHandler{
+		rewardDb: rewardDb,
+	}
+}
+
+type GetMismatchesRequest struct {
+	ValidatorStash string `form:"validator_stash" binding:"-"`
+	Start          int64  `form:"start" binding:"-"`
+	End            int64  `form:"end" binding:"-"`
+}
+
+func (h *getMismatchesHttpHandler) Handle(c *gin.Context) {
+	var req GetMismatchesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid validator_stash, start or/and end"))
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.ValidatorStash, req.Start, req.End)
+	if err != nil {
+		logger.Error(err)
+		http.ServerError(c, err)
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getMismatchesHttpHandler) getUseCase() *getMismatchesUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetMismatchesUseCase(h.rewardDb)
+	}
+	return h.useCase
+}
@@ -0,0 +1,107 @@
+package reward
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/types"
+)
+
+type MismatchItem struct {
+	Era                   int64               `json:"era"`
+	ValidatorStashAccount string              `json:"validator_stash_account"`
+	Height                int64               `json:"height"`
+	Time                  types.Time          `json:"time"`
+	PredictedAmount       types.Quantity      `json:"predicted_amount"`
+	ActualAmount          types.Quantity      `json:"actual_amount"`
+	Accounts              []MismatchedAccount `json:"accounts"`
+}
+
+type MismatchedAccount struct {
+	StashAccount    string         `json:"stash_account"`
+	PredictedAmount types.Quantity `json:"predicted_amount"`
+	ActualAmount    types.Quantity `json:"actual_amount"`
+}
+
+type MismatchesView struct {
+	Items []MismatchItem `json:"items"`
+}
+
+// ToMismatchesView groups discrepancies by validator and era. Discrepancies are expected to be ordered by era and validator
+func ToMismatchesView(discrepancies []model.RewardDiscrepancy) *MismatchesView {
+	items := []MismatchItem{}
+
+	for _, d := range discrepancies {
+		if len(items) == 0 || items[len(items)-1].Era != d.Era || items[len(items)-1].ValidatorStashAccount != d.ValidatorStashAccount {
+			items = append(items, MismatchItem{
+				Era:                   d.Era,
+				ValidatorStashAccount: d.ValidatorStashAccount,
+				Height:                d.Height,
+				Time:                  d.Time,
+				PredictedAmount:       types.NewQuantityFromInt64(0),
+				ActualAmount:          types.NewQuantityFromInt64(0),
+			})
+		}
+
+		item := &items[len(items)-1]
+		item.PredictedAmount.Add(d.PredictedAmount)
+		item.ActualAmount.Add(d.ActualAmount)
+		item.Accounts = append(item.Accounts, MismatchedAccount{
+			StashAccount:    d.StashAccount,
+			PredictedAmount: d.PredictedAmount,
+			ActualAmount:    d.ActualAmount,
+		})
+	}
+
+	return &MismatchesView{
+		Items: items,
+	}
+}
+
+type SummaryView struct {
+	GroupBy    model.RewardGroupBy   `json:"group_by"`
+	Items      []model.RewardSummary `json:"items"`
+	Total      SummaryTotal          `json:"total"`
+	Pagination Pagination            `json:"pagination"`
+}
+
+type SummaryTotal struct {
+	Count                     int64          `json:"count"`
+	TotalAmount               types.Quantity `json:"total_amount"`
+	ClaimedAmount             types.Quantity `json:"claimed_amount"`
+	UnclaimedAmount           types.Quantity `json:"unclaimed_amount"`
+	CommissionAmount          types.Quantity `json:"commission_amount"`
+	RewardAmount              types.Quantity `json:"reward_amount"`
+	CommissionAndRewardAmount types.Quantity `json:"commission_and_reward_amount"`
+}
+
+type Pagination struct {
+	Page       int64 `json:"page"`
+	Limit      int64 `json:"limit"`
+	TotalCount int64 `json:"total_count"`
+	TotalPages int64 `json:"total_pages"`
+}
+
+func ToSummaryView(summaries []model.RewardSummary, total model.RewardSummary, groupBy model.RewardGroupBy, page, limit, groupsCount int64) *SummaryView {
+	if summaries == nil {
+		summaries = []model.RewardSummary{}
+	}
+
+	return &SummaryView{
+		GroupBy: groupBy,
+		Items:   summaries,
+		Total: SummaryTotal{
+			Count:                     total.Count,
+			TotalAmount:               total.TotalAmount,
+			ClaimedAmount:             total.ClaimedAmount,
+			UnclaimedAmount:           total.UnclaimedAmount,
+			CommissionAmount:          total.CommissionAmount,
+			RewardAmount:              total.RewardAmount,
+			CommissionAndRewardAmount: total.CommissionAndRewardAmount,
+		},
+		Pagination: Pagination{
+			Page:       page,
+			Limit:      limit,
+			TotalCount: groupsCount,
+			TotalPages: (groupsCount + limit - 1) / limit,
+		},
+	}
+}
@@ -0,0 +1,24 @@
+package slash
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+type getForAddressUseCase struct {
+	slashDb store.Slashes
+}
+
+func NewGetForAddressUseCase(slashDb store.Slashes) *getForAddressUseCase {
+	return &getForAddressUseCase{
+		slashDb: slashDb,
+	}
+}
+
+func (uc *getForAddressUseCase) Execute(address string, minHeight *int64) (*ListView, error) {
+	slashSeqs, err := uc.slashDb.FindByAddress(address, minHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToListView(slashSeqs), nil
+}
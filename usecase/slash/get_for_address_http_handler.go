@@ -0,0 +1,63 @@
+package slash
+
+import (
+	"errors"
+
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/usecase/http"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*getForAddressHttpHandler)(nil)
+)
+
+type getForAddressHttpHandler struct {
+	useCase *getForAddressUseCase
+
+	slashDb store.Slashes
+}
+
+func NewGetForAddressHttpHandler(slashDb store.Slashes) *getForAddressHttpHandler {
+	return &getForAddressHttpHandler{
+		slashDb: slashDb,
+	}
+}
+
+type GetForAddressRequest struct {
+	Address string `uri:"address" binding:"required"`
+	After   *int64 `form:"after" binding:"-"`
+}
+
+func (h *getForAddressHttpHandler) Handle(c *gin.Context) {
+	var req GetForAddressRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid address"))
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid after"))
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Address, req.After)
+	if err != nil {
+		logger.Error(err)
+		http.ServerError(c, err)
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getForAddressHttpHandler) getUseCase() *getForAddressUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetForAddressUseCase(h.slashDb)
+	}
+	return h.useCase
+}
@@ -0,0 +1,43 @@
+package slash
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/types"
+)
+
+type ListItem struct {
+	Height                int64          `json:"height"`
+	Time                  types.Time     `json:"time"`
+	Era                   int64          `json:"era"`
+	Kind                  string         `json:"kind"`
+	StashAccount          string         `json:"stash_account"`
+	ValidatorStashAccount string         `json:"validator_stash_account"`
+	IsValidator           bool           `json:"is_validator"`
+	Amount                types.Quantity `json:"amount"`
+	OffenceKind           string         `json:"offence_kind,omitempty"`
+}
+
+type ListView struct {
+	Items []ListItem `json:"items"`
+}
+
+func ToListView(slashSeqs []model.SlashSeq) *ListView {
+	items := make([]ListItem, len(slashSeqs))
+	for i, m := range slashSeqs {
+		items[i] = ListItem{
+			Height:                m.Height,
+			Time:                  m.Time,
+			Era:                   m.Era,
+			Kind:                  m.Kind.String(),
+			StashAccount:          m.StashAccount,
+			ValidatorStashAccount: m.ValidatorStashAccount,
+			IsValidator:           m.IsValidator(),
+			Amount:                m.Amount,
+			OffenceKind:           m.OffenceKind,
+		}
+	}
+
+	return &ListView{
+		Items: items,
+	}
+}
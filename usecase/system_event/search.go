@@ -0,0 +1,98 @@
+package system_event
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+const (
+	defaultSearchLimit int64 = 100
+	maxSearchLimit     int64 = 1000
+)
+
+var (
+	ErrInvalidCursor      = errors.New("invalid cursor")
+	ErrInvalidHeightRange = errors.New("start_height must not be greater than end_height")
+	ErrInvalidTimeRange   = errors.New("start_time must not be after end_time")
+	ErrInvalidEraRange    = errors.New("start_era must not be greater than end_era")
+)
+
+type searchUseCase struct {
+	systemEventDb store.SystemEvents
+}
+
+func NewSearchUseCase(systemEventDb store.SystemEvents) *searchUseCase {
+	return &searchUseCase{
+		systemEventDb: systemEventDb,
+	}
+}
+
+// Validate checks ranges of query
+func (uc *searchUseCase) Validate(query store.SystemEventQuery) error {
+	if query.StartHeight != nil && query.EndHeight != nil && *query.StartHeight > *query.EndHeight {
+		return ErrInvalidHeightRange
+	}
+	if query.StartTime != nil && query.EndTime != nil && query.StartTime.After(*query.EndTime) {
+		return ErrInvalidTimeRange
+	}
+	if query.StartEra != nil && query.EndEra != nil && *query.StartEra > *query.EndEra {
+		return ErrInvalidEraRange
+	}
+	return nil
+}
+
+// Execute returns page of system events matching query, most recent first. Next page is requested with returned cursor
+func (uc *searchUseCase) Execute(query store.SystemEventQuery) (*SearchView, error) {
+	if query.Limit <= 0 {
+		query.Limit = defaultSearchLimit
+	} else if query.Limit > maxSearchLimit {
+		query.Limit = maxSearchLimit
+	}
+	limit := query.Limit
+
+	// One more system event is fetched to find out if there is next page
+	query.Limit = limit + 1
+	systemEvents, err := uc.systemEventDb.FindByQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if int64(len(systemEvents)) > limit {
+		systemEvents = systemEvents[:limit]
+		last := systemEvents[limit-1]
+		nextCursor = EncodeCursor(store.SystemEventCursor{Height: last.Height, ID: int64(last.ID)})
+	}
+
+	return ToSearchView(systemEvents, nextCursor), nil
+}
+
+// EncodeCursor returns opaque representation of cursor
+func EncodeCursor(cursor store.SystemEventCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", cursor.Height, cursor.ID)))
+}
+
+// DecodeCursor parses cursor returned by EncodeCursor
+func DecodeCursor(value string) (*store.SystemEventCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor store.SystemEventCursor
+	if _, err := fmt.Sscanf(string(raw), "%d:%d", &cursor.Height, &cursor.ID); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,92 @@
+package system_event
+
+import (
+	"errors"
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/usecase/http"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*searchHttpHandler)(nil)
+)
+
+type searchHttpHandler struct {
+	useCase *searchUseCase
+
+	systemEventDb store.SystemEvents
+}
+
+func NewSearchHttpHandler(systemEventDb store.SystemEvents) *searchHttpHandler {
+	return &searchHttpHandler{
+		systemEventDb: systemEventDb,
+	}
+}
+
+type SearchRequest struct {
+	Actors      []string  `form:"actor" binding:"-"`
+	Kinds       []string  `form:"kind" binding:"-"`
+	StartHeight *int64    `form:"start_height" binding:"-"`
+	EndHeight   *int64    `form:"end_height" binding:"-"`
+	StartTime   time.Time `form:"start_time" binding:"-" time_format:"2006-01-02T15:04:05Z07:00"`
+	EndTime     time.Time `form:"end_time" binding:"-" time_format:"2006-01-02T15:04:05Z07:00"`
+	StartEra    *int64    `form:"start_era" binding:"-"`
+	EndEra      *int64    `form:"end_era" binding:"-"`
+	Cursor      string    `form:"cursor" binding:"-"`
+	Limit       int64     `form:"limit" binding:"-"`
+}
+
+func (h *searchHttpHandler) Handle(c *gin.Context) {
+	var req SearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid height, time, era, cursor or/and limit"))
+		return
+	}
+
+	query := store.SystemEventQuery{
+		Actors:      req.Actors,
+		Kinds:       req.Kinds,
+		StartHeight: req.StartHeight,
+		EndHeight:   req.EndHeight,
+		StartTime:   timeOrNil(req.StartTime),
+		EndTime:     timeOrNil(req.EndTime),
+		StartEra:    req.StartEra,
+		EndEra:      req.EndEra,
+		Limit:       req.Limit,
+	}
+	if req.Cursor != "" {
+		cursor, err := DecodeCursor(req.Cursor)
+		if err != nil {
+			http.BadRequest(c, err)
+			return
+		}
+		query.Before = cursor
+	}
+
+	if err := h.getUseCase().Validate(query); err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(query)
+	if err != nil {
+		logger.Error(err)
+		http.ServerError(c, err)
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *searchHttpHandler) getUseCase() *searchUseCase {
+	if h.useCase == nil {
+		h.useCase = NewSearchUseCase(h.systemEventDb)
+	}
+	return h.useCase
+}
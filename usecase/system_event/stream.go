@@ -0,0 +1,110 @@
+package system_event
+
+import (
+	"context"
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+const (
+	// streamMaxHeights limits number of heights read at once when stream catches up
+	streamMaxHeights int64 = 1000
+)
+
+type streamUseCase struct {
+	cfg *config.Config
+
+	syncableDb    store.Syncables
+	systemEventDb store.SystemEvents
+}
+
+func NewStreamUseCase(cfg *config.Config, syncableDb store.Syncables, systemEventDb store.SystemEvents) *streamUseCase {
+	return &streamUseCase{
+		cfg: cfg,
+
+		syncableDb:    syncableDb,
+		systemEventDb: systemEventDb,
+	}
+}
+
+// Execute sends system events of heights above after (or above most recent processed height when after is nil) and keeps
+// sending system events of newly processed heights until context is done. Height message is sent after all system events of heights
+// up to it were sent, so its height can be used as after when client reconnects.
+func (uc *streamUseCase) Execute(ctx context.Context, after *int64, actor string, kinds []string, send func(StreamMessage) error) error {
+	pollInterval, err := time.ParseDuration(uc.cfg.SystemEventStreamPollInterval)
+	if err != nil {
+		return err
+	}
+
+	var cursor int64
+	if after != nil {
+		cursor = *after
+	} else {
+		mostRecent, err := uc.syncableDb.FindMostRecentProcessed()
+		if err == nil {
+			cursor = mostRecent.Height
+		} else if err != store.ErrNotFound {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	first := true
+	for {
+		if err := uc.sendNew(&cursor, first, actor, kinds, send); err != nil {
+			return err
+		}
+		first = false
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendNew sends system events processed since cursor and moves cursor to most recent processed height
+func (uc *streamUseCase) sendNew(cursor *int64, first bool, actor string, kinds []string, send func(StreamMessage) error) error {
+	mostRecent, err := uc.syncableDb.FindMostRecentProcessed()
+	if err == store.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if mostRecent.Height < *cursor {
+		// heights above most recent were rolled back, their system events are sent again once they are reindexed
+		*cursor = mostRecent.Height
+		return nil
+	}
+
+	if mostRecent.Height == *cursor && !first {
+		return nil
+	}
+
+	for *cursor < mostRecent.Height {
+		end := *cursor + streamMaxHeights
+		if end > mostRecent.Height {
+			end = mostRecent.Height
+		}
+
+		systemEvents, err := uc.systemEventDb.FindInHeightRange(*cursor, end, actor, kinds)
+		if err != nil {
+			return err
+		}
+
+		for _, systemEvent := range systemEvents {
+			if err := send(ToSystemEventMessage(systemEvent)); err != nil {
+				return err
+			}
+		}
+		*cursor = end
+	}
+
+	return send(ToHeightMessage(*mostRecent))
+}
@@ -0,0 +1,97 @@
+package system_event
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/usecase/http"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	lastEventIDHeader = "Last-Event-ID"
+)
+
+var (
+	_ types.HttpHandler = (*streamHttpHandler)(nil)
+)
+
+// streamHttpHandler streams system events as Server-Sent Events. Height events carry height as event id,
+// so clients reconnecting with Last-Event-ID header receive everything they missed
+type streamHttpHandler struct {
+	cfg *config.Config
+
+	useCase *streamUseCase
+
+	syncableDb    store.Syncables
+	systemEventDb store.SystemEvents
+}
+
+func NewStreamHttpHandler(cfg *config.Config, syncableDb store.Syncables, systemEventDb store.SystemEvents) *streamHttpHandler {
+	return &streamHttpHandler{
+		cfg: cfg,
+
+		syncableDb:    syncableDb,
+		systemEventDb: systemEventDb,
+	}
+}
+
+type StreamRequest struct {
+	After *int64   `form:"after" binding:"-"`
+	Actor string   `form:"actor" binding:"-"`
+	Kinds []string `form:"kind" binding:"-"`
+}
+
+func (h *streamHttpHandler) Handle(c *gin.Context) {
+	var req StreamRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid after, actor or/and kind"))
+		return
+	}
+	if lastEventID := c.GetHeader(lastEventIDHeader); lastEventID != "" {
+		after, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			http.BadRequest(c, errors.New("invalid Last-Event-ID"))
+			return
+		}
+		req.After = &after
+	}
+
+	c.Header("Content-Type", sse.ContentType)
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	err := h.getUseCase().Execute(c.Request.Context(), req.After, req.Actor, req.Kinds, func(msg StreamMessage) error {
+		event := sse.Event{
+			Event: string(msg.Type),
+			Data:  msg,
+		}
+		if msg.Type == StreamMessageHeight {
+			event.Id = strconv.FormatInt(msg.Height, 10)
+		}
+
+		if err := sse.Encode(c.Writer, event); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if err != nil && c.Request.Context().Err() == nil {
+		logger.Error(err)
+	}
+}
+
+func (h *streamHttpHandler) getUseCase() *streamUseCase {
+	if h.useCase == nil {
+		h.useCase = NewStreamUseCase(h.cfg, h.syncableDb, h.systemEventDb)
+	}
+	return h.useCase
+}
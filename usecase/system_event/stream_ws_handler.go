@@ -0,0 +1,80 @@
+package system_event
+
+import (
+	"context"
+	"errors"
+	gohttp "net/http"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/usecase/http"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+var (
+	_ types.HttpHandler = (*streamWsHandler)(nil)
+)
+
+// streamWsHandler streams system events as JSON messages over WebSocket
+type streamWsHandler struct {
+	cfg *config.Config
+
+	useCase *streamUseCase
+
+	syncableDb    store.Syncables
+	systemEventDb store.SystemEvents
+}
+
+func NewStreamWsHandler(cfg *config.Config, syncableDb store.Syncables, systemEventDb store.SystemEvents) *streamWsHandler {
+	return &streamWsHandler{
+		cfg: cfg,
+
+		syncableDb:    syncableDb,
+		systemEventDb: systemEventDb,
+	}
+}
+
+func (h *streamWsHandler) Handle(c *gin.Context) {
+	var req StreamRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid after, actor or/and kind"))
+		return
+	}
+
+	server := websocket.Server{
+		// stream is public and read only, so connections are accepted from any origin
+		Handshake: func(*websocket.Config, *gohttp.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			ctx, cancel := context.WithCancel(c.Request.Context())
+			defer cancel()
+
+			// messages from client are ignored, reading detects closed connection
+			go func() {
+				var msg []byte
+				for websocket.Message.Receive(ws, &msg) == nil {
+				}
+				cancel()
+			}()
+
+			err := h.getUseCase().Execute(ctx, req.After, req.Actor, req.Kinds, func(msg StreamMessage) error {
+				return websocket.JSON.Send(ws, msg)
+			})
+			if err != nil && ctx.Err() == nil {
+				logger.Error(err)
+			}
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+func (h *streamWsHandler) getUseCase() *streamUseCase {
+	if h.useCase == nil {
+		h.useCase = NewStreamUseCase(h.cfg, h.syncableDb, h.systemEventDb)
+	}
+	return h.useCase
+}
@@ -35,3 +35,69 @@ func ToListView(events []model.SystemEvent) *ListView {
 		Items: items,
 	}
 }
+
+// SearchView is page of system events. NextCursor is empty on last page
+type SearchView struct {
+	Items      []ListItem `json:"items"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
+
+func ToSearchView(events []model.SystemEvent, nextCursor string) *SearchView {
+	view := ToListView(events)
+	for i, m := range events {
+		view.Items[i].Model = m.Model
+	}
+
+	return &SearchView{
+		Items:      view.Items,
+		NextCursor: nextCursor,
+	}
+}
+
+const (
+	StreamMessageSystemEvent StreamMessageType = "system_event"
+	StreamMessageHeight      StreamMessageType = "height"
+)
+
+type StreamMessageType string
+
+// StreamMessage is message sent to system event stream clients. Height message announces that all system events up to its height were sent
+type StreamMessage struct {
+	Type        StreamMessageType `json:"type"`
+	Height      int64             `json:"height"`
+	Time        types.Time        `json:"time"`
+	SystemEvent *StreamItem       `json:"system_event,omitempty"`
+}
+
+type StreamItem struct {
+	ID    types.ID    `json:"id"`
+	Actor string      `json:"actor"`
+	Kind  string      `json:"kind"`
+	Data  types.Jsonb `json:"data"`
+}
+
+func ToSystemEventMessage(m model.SystemEvent) StreamMessage {
+	item := &StreamItem{
+		Actor: m.Actor,
+		Kind:  m.Kind.String(),
+		Data:  m.Data,
+	}
+	if m.Model != nil {
+		item.ID = m.ID
+	}
+
+	return StreamMessage{
+		Type:        StreamMessageSystemEvent,
+		Height:      m.Height,
+		Time:        m.Time,
+		SystemEvent: item,
+	}
+}
+
+func ToHeightMessage(m model.Syncable) StreamMessage {
+	return StreamMessage{
+		Type:   StreamMessageHeight,
+		Height: m.Height,
+		Time:   m.Time,
+	}
+}
@@ -7,12 +7,14 @@ import (
 
 type getByStashAccountUseCase struct {
 	accountEraSeqDb store.AccountEraSeq
+	identityDb      store.Identities
 	validatorDb     store.Validators
 }
 
-func NewGetByStashAccountUseCase(accountEraSeqDb store.AccountEraSeq, validatorDb store.Validators) *getByStashAccountUseCase {
+func NewGetByStashAccountUseCase(accountEraSeqDb store.AccountEraSeq, identityDb store.Identities, validatorDb store.Validators) *getByStashAccountUseCase {
 	return &getByStashAccountUseCase{
 		accountEraSeqDb: accountEraSeqDb,
+		identityDb:      identityDb,
 		validatorDb:     validatorDb,
 	}
 }
@@ -38,7 +40,12 @@ func (uc *getByStashAccountUseCase) Execute(stashAccount string, sessionsLimit i
 		return nil, err
 	}
 
-	return ToAggDetailsView(validatorAggs, sessionSequences, eraSequences, accountEraSeqs), nil
+	identityChanges, err := uc.identityDb.FindChangesByStashAccount(stashAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToAggDetailsView(validatorAggs, sessionSequences, eraSequences, accountEraSeqs, identityChanges), nil
 }
 
 func (uc *getByStashAccountUseCase) getSessionSequences(stashAccount string, sequencesLimit int64) ([]model.ValidatorSessionSeq, error) {
@@ -19,12 +19,14 @@ type getByStashAccountHttpHandler struct {
 	useCase *getByStashAccountUseCase
 
 	accountEraSeqDb store.AccountEraSeq
+	identityDb      store.Identities
 	validatorDb     store.Validators
 }
 
-func NewGetByStashAccountHttpHandler(accountEraSeqDb store.AccountEraSeq, validatorDb store.Validators) *getByStashAccountHttpHandler {
+func NewGetByStashAccountHttpHandler(accountEraSeqDb store.AccountEraSeq, identityDb store.Identities, validatorDb store.Validators) *getByStashAccountHttpHandler {
 	return &getByStashAccountHttpHandler{
 		accountEraSeqDb: accountEraSeqDb,
+		identityDb:      identityDb,
 		validatorDb:     validatorDb,
 	}
 }
@@ -61,7 +63,7 @@ func (h *getByStashAccountHttpHandler) Handle(c *gin.Context) {
 
 func (h *getByStashAccountHttpHandler) getUseCase() *getByStashAccountUseCase {
 	if h.useCase == nil {
-		return NewGetByStashAccountUseCase(h.accountEraSeqDb, h.validatorDb)
+		return NewGetByStashAccountUseCase(h.accountEraSeqDb, h.identityDb, h.validatorDb)
 
 	}
 	return h.useCase
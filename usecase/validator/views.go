@@ -30,9 +30,10 @@ type AggDetailsView struct {
 	LastSessionSequences []model.ValidatorSessionSeq `json:"last_session_sequences"`
 	LastEraSequences     []model.ValidatorEraSeq     `json:"last_era_sequences"`
 	LastDelegations      []*common.Delegation        `json:"delegations"`
+	IdentityHistory      []model.IdentityChange      `json:"identity_history"`
 }
 
-func ToAggDetailsView(m *model.ValidatorAgg, sessionSequences []model.ValidatorSessionSeq, eraSequences []model.ValidatorEraSeq, accountEraSequences []model.AccountEraSeq) *AggDetailsView {
+func ToAggDetailsView(m *model.ValidatorAgg, sessionSequences []model.ValidatorSessionSeq, eraSequences []model.ValidatorEraSeq, accountEraSequences []model.AccountEraSeq, identityChanges []model.IdentityChange) *AggDetailsView {
 	return &AggDetailsView{
 		Model:     m.Model,
 		Aggregate: m.Aggregate,
@@ -45,6 +46,7 @@ func ToAggDetailsView(m *model.ValidatorAgg, sessionSequences []model.ValidatorS
 		LastSessionSequences: sessionSequences,
 		LastEraSequences:     eraSequences,
 		LastDelegations:      common.ToDelegations(accountEraSequences),
+		IdentityHistory:      identityChanges,
 	}
 }
 
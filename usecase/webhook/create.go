@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/url"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+const (
+	secretLength = 32
+)
+
+var (
+	ErrInvalidUrl = errors.New("url must be absolute http or https url")
+)
+
+type createUseCase struct {
+	cfg *config.Config
+
+	syncableDb store.Syncables
+	webhookDb  store.Webhooks
+}
+
+func NewCreateUseCase(cfg *config.Config, syncableDb store.Syncables, webhookDb store.Webhooks) *createUseCase {
+	return &createUseCase{
+		cfg: cfg,
+
+		syncableDb: syncableDb,
+		webhookDb:  webhookDb,
+	}
+}
+
+// Execute creates subscription which receives system events of heights processed from now on. Random secret is generated when it's empty
+func (uc *createUseCase) Execute(actor string, kinds []string, targetUrl, secret string) (*CreatedSubscriptionView, error) {
+	if err := uc.validateUrl(targetUrl); err != nil {
+		return nil, err
+	}
+
+	if kinds == nil {
+		kinds = []string{}
+	}
+
+	if secret == "" {
+		var err error
+		if secret, err = generateSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	var lastHeight int64
+	mostRecent, err := uc.syncableDb.FindMostRecentProcessed()
+	if err == nil {
+		lastHeight = mostRecent.Height
+	} else if err != store.ErrNotFound {
+		return nil, err
+	}
+
+	subscription := &model.WebhookSubscription{
+		Actor:      actor,
+		Kinds:      kinds,
+		Url:        targetUrl,
+		Secret:     secret,
+		LastHeight: lastHeight,
+	}
+	if err := uc.webhookDb.CreateSubscription(subscription); err != nil {
+		return nil, err
+	}
+
+	return &CreatedSubscriptionView{
+		SubscriptionView: ToSubscriptionView(*subscription),
+		Secret:           secret,
+	}, nil
+}
+
+// validateUrl checks that url is absolute http or https url and that its host resolves only to public addresses
+// unless private urls are allowed
+func (uc *createUseCase) validateUrl(targetUrl string) error {
+	u, err := url.Parse(targetUrl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrInvalidUrl
+	}
+
+	if uc.cfg.WebhookAllowPrivateUrls {
+		return nil
+	}
+
+	ips, err := net.LookupIP(u.Hostname())
+	if err != nil {
+		return ErrInvalidUrl
+	}
+	for _, ip := range ips {
+		if !isPublicIP(ip) {
+			return ErrPrivateAddress
+		}
+	}
+	return nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, secretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
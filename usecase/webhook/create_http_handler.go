@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"errors"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/usecase/http"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*createHttpHandler)(nil)
+)
+
+type createHttpHandler struct {
+	cfg     *config.Config
+	useCase *createUseCase
+
+	syncableDb store.Syncables
+	webhookDb  store.Webhooks
+}
+
+func NewCreateHttpHandler(cfg *config.Config, syncableDb store.Syncables, webhookDb store.Webhooks) *createHttpHandler {
+	return &createHttpHandler{
+		cfg: cfg,
+
+		syncableDb: syncableDb,
+		webhookDb:  webhookDb,
+	}
+}
+
+type CreateRequest struct {
+	Actor  string   `json:"actor"`
+	Kinds  []string `json:"kinds"`
+	Url    string   `json:"url" binding:"required"`
+	Secret string   `json:"secret"`
+}
+
+func (h *createHttpHandler) Handle(c *gin.Context) {
+	var req CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid subscription"))
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Actor, req.Kinds, req.Url, req.Secret)
+	if err == ErrInvalidUrl || err == ErrPrivateAddress {
+		http.BadRequest(c, err)
+		return
+	}
+	if err != nil {
+		logger.Error(err)
+		http.ServerError(c, err)
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *createHttpHandler) getUseCase() *createUseCase {
+	if h.useCase == nil {
+		h.useCase = NewCreateUseCase(h.cfg, h.syncableDb, h.webhookDb)
+	}
+	return h.useCase
+}
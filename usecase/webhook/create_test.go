@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	mock "github.com/figment-networks/polkadothub-indexer/mock/store"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/golang/mock/gomock"
+)
+
+func TestCreateUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		description  string
+		url          string
+		allowPrivate bool
+		expectErr    error
+	}{
+		{"rejects relative url", "/hook", false, ErrInvalidUrl},
+		{"rejects non http url", "file:///etc/passwd", false, ErrInvalidUrl},
+		{"rejects loopback address", "http://127.0.0.1:8081/hook", false, ErrPrivateAddress},
+		{"rejects localhost", "http://localhost/hook", false, ErrPrivateAddress},
+		{"rejects private address", "https://10.0.0.5/hook", false, ErrPrivateAddress},
+		{"rejects link-local address", "http://169.254.169.254/latest/meta-data", false, ErrPrivateAddress},
+		{"rejects ipv6 loopback address", "http://[::1]/hook", false, ErrPrivateAddress},
+		{"rejects ipv4 mapped loopback address", "http://[::ffff:127.0.0.1]/hook", false, ErrPrivateAddress},
+		{"accepts public address", "https://8.8.8.8/hook", false, nil},
+		{"accepts private address when allowed", "http://127.0.0.1:8081/hook", true, nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			syncableDbMock := mock.NewMockSyncables(ctrl)
+			webhookDbMock := mock.NewMockWebhooks(ctrl)
+
+			if tt.expectErr == nil {
+				syncableDbMock.EXPECT().FindMostRecentProcessed().Return(nil, store.ErrNotFound)
+				webhookDbMock.EXPECT().CreateSubscription(gomock.Any()).DoAndReturn(func(s *model.WebhookSubscription) error {
+					s.Model = &model.Model{ID: 1}
+					return nil
+				})
+			}
+
+			cfg := &config.Config{WebhookAllowPrivateUrls: tt.allowPrivate}
+			resp, err := NewCreateUseCase(cfg, syncableDbMock, webhookDbMock).Execute("", nil, tt.url, "")
+			if err != tt.expectErr {
+				t.Errorf("unexpected error, want: %v; got: %v", tt.expectErr, err)
+				return
+			}
+			if err == nil && (resp.Url != tt.url || resp.Secret == "" || resp.LastHeight != 0) {
+				t.Errorf("unexpected subscription: %+v", resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package webhook
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+type deleteUseCase struct {
+	webhookDb store.Webhooks
+}
+
+func NewDeleteUseCase(webhookDb store.Webhooks) *deleteUseCase {
+	return &deleteUseCase{
+		webhookDb: webhookDb,
+	}
+}
+
+// Execute deletes subscription and its delivery log
+func (uc *deleteUseCase) Execute(id int64) error {
+	return uc.webhookDb.DeleteSubscription(id)
+}
@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"errors"
+
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/usecase/http"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*deleteHttpHandler)(nil)
+)
+
+type deleteHttpHandler struct {
+	useCase *deleteUseCase
+
+	webhookDb store.Webhooks
+}
+
+func NewDeleteHttpHandler(webhookDb store.Webhooks) *deleteHttpHandler {
+	return &deleteHttpHandler{
+		webhookDb: webhookDb,
+	}
+}
+
+type DeleteRequest struct {
+	ID int64 `uri:"id" binding:"required"`
+}
+
+func (h *deleteHttpHandler) Handle(c *gin.Context) {
+	var req DeleteRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid id"))
+		return
+	}
+
+	err := h.getUseCase().Execute(req.ID)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, gin.H{"id": req.ID})
+}
+
+func (h *deleteHttpHandler) getUseCase() *deleteUseCase {
+	if h.useCase == nil {
+		h.useCase = NewDeleteUseCase(h.webhookDb)
+	}
+	return h.useCase
+}
@@ -0,0 +1,194 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+const (
+	deliverBatchSize int64 = 500
+
+	// deliverMaxHeights limits number of heights read at once when subscription catches up
+	deliverMaxHeights int64 = 1000
+
+	// maxBackoffDoublings limits growth of retry interval
+	maxBackoffDoublings int64 = 16
+)
+
+type deliverUseCase struct {
+	cfg *config.Config
+
+	syncableDb    store.Syncables
+	systemEventDb store.SystemEvents
+	webhookDb     store.Webhooks
+}
+
+func NewDeliverUseCase(cfg *config.Config, syncableDb store.Syncables, systemEventDb store.SystemEvents, webhookDb store.Webhooks) *deliverUseCase {
+	return &deliverUseCase{
+		cfg: cfg,
+
+		syncableDb:    syncableDb,
+		systemEventDb: systemEventDb,
+		webhookDb:     webhookDb,
+	}
+}
+
+// Execute queues deliveries of system events of heights processed since last run and posts pending deliveries which are due
+func (uc *deliverUseCase) Execute(ctx context.Context) error {
+	timeout, err := time.ParseDuration(uc.cfg.WebhookTimeout)
+	if err != nil {
+		return err
+	}
+	retryInterval, err := time.ParseDuration(uc.cfg.WebhookRetryInterval)
+	if err != nil {
+		return err
+	}
+
+	subscriptions, err := uc.webhookDb.FindSubscriptions()
+	if err != nil {
+		return err
+	}
+
+	// Heights are processed in order, so system events of heights up to most recent processed one are final until rollback
+	mostRecent, err := uc.syncableDb.FindMostRecentProcessed()
+	if err == store.ErrNotFound {
+		mostRecent = nil
+	} else if err != nil {
+		return err
+	}
+
+	subscriptionLookup := make(map[int64]model.WebhookSubscription, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if mostRecent != nil {
+			if err := uc.enqueue(&subscription, mostRecent.Height); err != nil {
+				return err
+			}
+		}
+		subscriptionLookup[int64(subscription.ID)] = subscription
+	}
+
+	s := newSender(timeout, uc.cfg.WebhookAllowPrivateUrls)
+	for {
+		deliveries, err := uc.webhookDb.FindDueDeliveries(time.Now(), deliverBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, delivery := range deliveries {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			subscription, ok := subscriptionLookup[delivery.SubscriptionID]
+			if !ok {
+				continue
+			}
+
+			if err := uc.deliver(ctx, s, subscription, &delivery, retryInterval); err != nil {
+				return err
+			}
+		}
+
+		if int64(len(deliveries)) < deliverBatchSize {
+			return nil
+		}
+	}
+}
+
+// enqueue creates pending deliveries for system events matching subscription up to given height and moves its cursor to it
+func (uc *deliverUseCase) enqueue(subscription *model.WebhookSubscription, height int64) error {
+	if height < subscription.LastHeight {
+		// heights above given height were rolled back, their system events are queued again once they are reindexed
+		subscription.LastHeight = height
+		return uc.webhookDb.SaveSubscription(subscription)
+	}
+
+	for subscription.LastHeight < height {
+		end := subscription.LastHeight + deliverMaxHeights
+		if end > height {
+			end = height
+		}
+
+		systemEvents, err := uc.systemEventDb.FindInHeightRange(subscription.LastHeight, end, subscription.Actor, subscription.Kinds)
+		if err != nil {
+			return err
+		}
+
+		for _, systemEvent := range systemEvents {
+			payload, err := json.Marshal(ToEventPayload(int64(subscription.ID), systemEvent))
+			if err != nil {
+				return err
+			}
+
+			delivery := &model.WebhookDelivery{
+				SubscriptionID: int64(subscription.ID),
+				SystemEventID:  int64(systemEvent.ID),
+				Payload:        types.Jsonb{RawMessage: payload},
+				Status:         model.WebhookDeliveryPending,
+			}
+			if err := uc.webhookDb.CreateDelivery(delivery); err != nil {
+				return err
+			}
+		}
+
+		subscription.LastHeight = end
+		if err := uc.webhookDb.SaveSubscription(subscription); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliver posts delivery to subscription url and records result of attempt. Failed delivery is retried with exponential
+// backoff until max attempts are reached
+func (uc *deliverUseCase) deliver(ctx context.Context, s *sender, subscription model.WebhookSubscription, delivery *model.WebhookDelivery, retryInterval time.Duration) error {
+	var payload EventPayload
+	if err := json.Unmarshal(delivery.Payload.RawMessage, &payload); err != nil {
+		return err
+	}
+
+	statusCode, err := s.send(ctx, subscription.Url, subscription.Secret, int64(delivery.ID), payload.Kind, delivery.Payload.RawMessage)
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.NextAttemptAt = nil
+	if statusCode > 0 {
+		code := int64(statusCode)
+		delivery.LastStatusCode = &code
+	}
+
+	if err == nil {
+		delivery.Status = model.WebhookDeliveryDelivered
+		delivery.DeliveredAt = types.NewTimeFromTime(now)
+		delivery.LastError = nil
+	} else {
+		msg := err.Error()
+		delivery.LastError = &msg
+
+		if delivery.Attempts >= uc.cfg.WebhookMaxAttempts {
+			delivery.Status = model.WebhookDeliveryFailed
+			logger.Info(fmt.Sprintf("webhook delivery failed [delivery=%d] [subscription=%d] [attempts=%d] [error=%s]", delivery.ID, delivery.SubscriptionID, delivery.Attempts, msg))
+		} else {
+			delivery.NextAttemptAt = types.NewTimeFromTime(now.Add(getRetryBackoff(retryInterval, delivery.Attempts)))
+		}
+	}
+
+	return uc.webhookDb.SaveDelivery(delivery)
+}
+
+// getRetryBackoff returns interval doubled with each failed attempt
+func getRetryBackoff(retryInterval time.Duration, attempts int64) time.Duration {
+	doublings := attempts - 1
+	if doublings > maxBackoffDoublings {
+		doublings = maxBackoffDoublings
+	}
+	return retryInterval * time.Duration(int64(1)<<uint(doublings))
+}
@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+type DeliverCmdHandler struct {
+	cfg *config.Config
+
+	useCase *deliverUseCase
+
+	syncableDb    store.Syncables
+	systemEventDb store.SystemEvents
+	webhookDb     store.Webhooks
+}
+
+func NewDeliverCmdHandler(cfg *config.Config, syncableDb store.Syncables, systemEventDb store.SystemEvents, webhookDb store.Webhooks) *DeliverCmdHandler {
+	return &DeliverCmdHandler{
+		cfg: cfg,
+
+		syncableDb:    syncableDb,
+		systemEventDb: systemEventDb,
+		webhookDb:     webhookDb,
+	}
+}
+
+func (h *DeliverCmdHandler) Handle(ctx context.Context) {
+	logger.Info("running webhook deliver use case [handler=cmd]")
+
+	err := h.getUseCase().Execute(ctx)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *DeliverCmdHandler) getUseCase() *deliverUseCase {
+	if h.useCase == nil {
+		h.useCase = NewDeliverUseCase(h.cfg, h.syncableDb, h.systemEventDb, h.webhookDb)
+	}
+	return h.useCase
+}
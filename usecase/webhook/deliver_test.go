@@ -0,0 +1,224 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	mock "github.com/figment-networks/polkadothub-indexer/mock/store"
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+var (
+	testCfg = &config.Config{
+		WebhookTimeout:       "1s",
+		WebhookRetryInterval: "30s",
+		WebhookMaxAttempts:   3,
+		// test server listens on loopback address
+		WebhookAllowPrivateUrls: true,
+	}
+)
+
+func TestDeliverUseCase_Execute(t *testing.T) {
+	systemEvent := model.SystemEvent{
+		Model:  &model.Model{ID: 11},
+		Height: 20,
+		Actor:  "validator1",
+		Kind:   model.SystemEventSlashed,
+		Data:   types.Jsonb{RawMessage: []byte(`{"era":182}`)},
+	}
+
+	tests := []struct {
+		description   string
+		statusCode    int
+		attempts      int64
+		expectStatus  model.WebhookDeliveryStatus
+		expectRetry   bool
+		expectError   bool
+		expectBackoff time.Duration
+	}{
+		{"marks delivery delivered when target responds with 2xx", http.StatusOK, 0, model.WebhookDeliveryDelivered, false, false, 0},
+		{"schedules retry when target responds with error", http.StatusInternalServerError, 0, model.WebhookDeliveryPending, true, true, 30 * time.Second},
+		{"doubles retry interval with each attempt", http.StatusBadGateway, 1, model.WebhookDeliveryPending, true, true, time.Minute},
+		{"marks delivery failed when max attempts are reached", http.StatusNotFound, 2, model.WebhookDeliveryFailed, false, true, 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var received []byte
+			var receivedSignature, receivedKind string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received, _ = ioutil.ReadAll(r.Body)
+				receivedSignature = r.Header.Get(SignatureHeader)
+				receivedKind = r.Header.Get(KindHeader)
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			subscription := model.WebhookSubscription{
+				Model:      &model.Model{ID: 5},
+				Actor:      "validator1",
+				Kinds:      []string{model.SystemEventSlashed.String()},
+				Url:        server.URL,
+				Secret:     "secret",
+				LastHeight: 10,
+			}
+
+			syncableDbMock := mock.NewMockSyncables(ctrl)
+			systemEventDbMock := mock.NewMockSystemEvents(ctrl)
+			webhookDbMock := mock.NewMockWebhooks(ctrl)
+
+			webhookDbMock.EXPECT().FindSubscriptions().Return([]model.WebhookSubscription{subscription}, nil)
+			syncableDbMock.EXPECT().FindMostRecentProcessed().Return(&model.Syncable{Height: 25}, nil)
+			systemEventDbMock.EXPECT().FindInHeightRange(int64(10), int64(25), "validator1", []string{"slashed"}).Return([]model.SystemEvent{systemEvent}, nil)
+
+			var queued model.WebhookDelivery
+			webhookDbMock.EXPECT().CreateDelivery(gomock.Any()).DoAndReturn(func(delivery *model.WebhookDelivery) error {
+				delivery.Model = &model.Model{ID: 7}
+				delivery.Attempts = tt.attempts
+				queued = *delivery
+				return nil
+			})
+			webhookDbMock.EXPECT().SaveSubscription(gomock.Any()).DoAndReturn(func(s *model.WebhookSubscription) error {
+				if s.LastHeight != 25 {
+					t.Errorf("unexpected subscription cursor, want: 25; got: %d", s.LastHeight)
+				}
+				return nil
+			})
+			webhookDbMock.EXPECT().FindDueDeliveries(gomock.Any(), deliverBatchSize).DoAndReturn(func(time.Time, int64) ([]model.WebhookDelivery, error) {
+				return []model.WebhookDelivery{queued}, nil
+			})
+
+			var saved model.WebhookDelivery
+			webhookDbMock.EXPECT().SaveDelivery(gomock.Any()).DoAndReturn(func(delivery *model.WebhookDelivery) error {
+				saved = *delivery
+				return nil
+			})
+
+			start := time.Now()
+			if err := NewDeliverUseCase(testCfg, syncableDbMock, systemEventDbMock, webhookDbMock).Execute(context.Background()); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			var payload EventPayload
+			if err := json.Unmarshal(received, &payload); err != nil {
+				t.Errorf("unexpected payload %s: %v", received, err)
+				return
+			}
+			if payload.SubscriptionID != 5 || payload.SystemEventID != 11 || payload.Actor != "validator1" || string(payload.Data.RawMessage) != `{"era":182}` {
+				t.Errorf("unexpected payload: %+v", payload)
+			}
+			if receivedSignature != Sign("secret", received) {
+				t.Errorf("unexpected signature: %s", receivedSignature)
+			}
+			if receivedKind != "slashed" {
+				t.Errorf("unexpected kind header: %s", receivedKind)
+			}
+
+			if saved.Status != tt.expectStatus {
+				t.Errorf("unexpected status, want: %s; got: %s", tt.expectStatus, saved.Status)
+			}
+			if saved.Attempts != tt.attempts+1 {
+				t.Errorf("unexpected attempts, want: %d; got: %d", tt.attempts+1, saved.Attempts)
+			}
+			if saved.LastStatusCode == nil || *saved.LastStatusCode != int64(tt.statusCode) {
+				t.Errorf("unexpected last status code: %v", saved.LastStatusCode)
+			}
+			if (saved.LastError != nil) != tt.expectError {
+				t.Errorf("unexpected last error: %v", saved.LastError)
+			}
+			if (saved.NextAttemptAt != nil) != tt.expectRetry {
+				t.Errorf("unexpected next attempt: %v", saved.NextAttemptAt)
+			}
+			if tt.expectRetry {
+				backoff := saved.NextAttemptAt.Sub(start)
+				if backoff < tt.expectBackoff || backoff > tt.expectBackoff+time.Second {
+					t.Errorf("unexpected backoff, want: %v; got: %v", tt.expectBackoff, backoff)
+				}
+			}
+			if tt.expectStatus == model.WebhookDeliveryDelivered && saved.DeliveredAt == nil {
+				t.Errorf("delivered at should be set")
+			}
+		})
+	}
+}
+
+func TestDeliverUseCase_enqueue(t *testing.T) {
+	tests := []struct {
+		description  string
+		lastHeight   int64
+		height       int64
+		expectRanges [][2]int64
+	}{
+		{"does nothing when cursor is at height", 10, 10, nil},
+		{"reads heights in chunks up to height", 10, 10 + deliverMaxHeights + 5, [][2]int64{{10, 10 + deliverMaxHeights}, {10 + deliverMaxHeights, 10 + deliverMaxHeights + 5}}},
+		{"moves cursor back when heights were rolled back", 30, 20, nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			systemEventDbMock := mock.NewMockSystemEvents(ctrl)
+			webhookDbMock := mock.NewMockWebhooks(ctrl)
+
+			for _, r := range tt.expectRanges {
+				systemEventDbMock.EXPECT().FindInHeightRange(r[0], r[1], "", []string{}).Return(nil, nil)
+				webhookDbMock.EXPECT().SaveSubscription(gomock.Any()).Return(nil)
+			}
+			if tt.height < tt.lastHeight {
+				webhookDbMock.EXPECT().SaveSubscription(gomock.Any()).Return(nil)
+			}
+
+			subscription := &model.WebhookSubscription{Model: &model.Model{ID: 5}, Kinds: []string{}, LastHeight: tt.lastHeight}
+			if err := NewDeliverUseCase(testCfg, nil, systemEventDbMock, webhookDbMock).enqueue(subscription, tt.height); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if subscription.LastHeight != tt.height {
+				t.Errorf("unexpected subscription cursor, want: %d; got: %d", tt.height, subscription.LastHeight)
+			}
+		})
+	}
+}
+
+func TestSender_refusesPrivateAddress(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	_, err := newSender(time.Second, false).send(context.Background(), server.URL, "secret", 1, "slashed", []byte(`{}`))
+	if err == nil || !strings.Contains(err.Error(), ErrPrivateAddress.Error()) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if called {
+		t.Errorf("request should not reach loopback address")
+	}
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '{"kind":"slashed"}' | openssl dgst -sha256 -hmac secret
+	expected := "sha256=0cb9c7afce7215b9fd44aae758353e7f63145ea50770a64a9e3f5f63292cc379"
+	if got := Sign("secret", []byte(`{"kind":"slashed"}`)); got != expected {
+		t.Errorf("unexpected signature, want: %s; got: %s", expected, got)
+	}
+}
@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/figment-networks/polkadothub-indexer/config"
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+)
+
+var (
+	_ types.WorkerHandler = (*deliverWorkerHandler)(nil)
+)
+
+type deliverWorkerHandler struct {
+	cfg *config.Config
+
+	useCase *deliverUseCase
+
+	syncableDb    store.Syncables
+	systemEventDb store.SystemEvents
+	webhookDb     store.Webhooks
+}
+
+func NewDeliverWorkerHandler(cfg *config.Config, syncableDb store.Syncables, systemEventDb store.SystemEvents, webhookDb store.Webhooks) *deliverWorkerHandler {
+	return &deliverWorkerHandler{
+		cfg: cfg,
+
+		syncableDb:    syncableDb,
+		systemEventDb: systemEventDb,
+		webhookDb:     webhookDb,
+	}
+}
+
+func (h *deliverWorkerHandler) Handle() {
+	ctx := context.Background()
+
+	logger.Info("running webhook deliver use case [handler=worker]")
+
+	err := h.getUseCase().Execute(ctx)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *deliverWorkerHandler) getUseCase() *deliverUseCase {
+	if h.useCase == nil {
+		h.useCase = NewDeliverUseCase(h.cfg, h.syncableDb, h.systemEventDb, h.webhookDb)
+	}
+	return h.useCase
+}
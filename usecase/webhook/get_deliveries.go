@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+const (
+	defaultDeliveriesLimit int64 = 100
+	maxDeliveriesLimit     int64 = 1000
+)
+
+type getDeliveriesUseCase struct {
+	webhookDb store.Webhooks
+}
+
+func NewGetDeliveriesUseCase(webhookDb store.Webhooks) *getDeliveriesUseCase {
+	return &getDeliveriesUseCase{
+		webhookDb: webhookDb,
+	}
+}
+
+// Execute returns most recent deliveries of subscription
+func (uc *getDeliveriesUseCase) Execute(id int64, limit int64) (*DeliveryListView, error) {
+	if _, err := uc.webhookDb.FindSubscriptionByID(id); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultDeliveriesLimit
+	} else if limit > maxDeliveriesLimit {
+		limit = maxDeliveriesLimit
+	}
+
+	deliveries, err := uc.webhookDb.FindDeliveriesBySubscription(id, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToDeliveryListView(deliveries), nil
+}
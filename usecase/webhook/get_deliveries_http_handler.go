@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"errors"
+
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/usecase/http"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*getDeliveriesHttpHandler)(nil)
+)
+
+type getDeliveriesHttpHandler struct {
+	useCase *getDeliveriesUseCase
+
+	webhookDb store.Webhooks
+}
+
+func NewGetDeliveriesHttpHandler(webhookDb store.Webhooks) *getDeliveriesHttpHandler {
+	return &getDeliveriesHttpHandler{
+		webhookDb: webhookDb,
+	}
+}
+
+type GetDeliveriesRequest struct {
+	ID    int64 `uri:"id" binding:"required"`
+	Limit int64 `form:"limit" binding:"-"`
+}
+
+func (h *getDeliveriesHttpHandler) Handle(c *gin.Context) {
+	var req GetDeliveriesRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid id"))
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid limit"))
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.ID, req.Limit)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getDeliveriesHttpHandler) getUseCase() *getDeliveriesUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetDeliveriesUseCase(h.webhookDb)
+	}
+	return h.useCase
+}
@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/store"
+)
+
+type getListUseCase struct {
+	webhookDb store.Webhooks
+}
+
+func NewGetListUseCase(webhookDb store.Webhooks) *getListUseCase {
+	return &getListUseCase{
+		webhookDb: webhookDb,
+	}
+}
+
+func (uc *getListUseCase) Execute() (*SubscriptionListView, error) {
+	subscriptions, err := uc.webhookDb.FindSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return ToSubscriptionListView(subscriptions), nil
+}
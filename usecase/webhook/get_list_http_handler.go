@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/store"
+	"github.com/figment-networks/polkadothub-indexer/types"
+	"github.com/figment-networks/polkadothub-indexer/usecase/http"
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*getListHttpHandler)(nil)
+)
+
+type getListHttpHandler struct {
+	useCase *getListUseCase
+
+	webhookDb store.Webhooks
+}
+
+func NewGetListHttpHandler(webhookDb store.Webhooks) *getListHttpHandler {
+	return &getListHttpHandler{
+		webhookDb: webhookDb,
+	}
+}
+
+func (h *getListHttpHandler) Handle(c *gin.Context) {
+	resp, err := h.getUseCase().Execute()
+	if err != nil {
+		logger.Error(err)
+		http.ServerError(c, err)
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getListHttpHandler) getUseCase() *getListUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetListUseCase(h.webhookDb)
+	}
+	return h.useCase
+}
@@ -0,0 +1,110 @@
+package webhook
+
+import (
+	"github.com/figment-networks/polkadothub-indexer/model"
+	"github.com/figment-networks/polkadothub-indexer/types"
+)
+
+type SubscriptionView struct {
+	ID         int64      `json:"id"`
+	CreatedAt  types.Time `json:"created_at"`
+	Actor      string     `json:"actor"`
+	Kinds      []string   `json:"kinds"`
+	Url        string     `json:"url"`
+	LastHeight int64      `json:"last_height"`
+}
+
+func ToSubscriptionView(m model.WebhookSubscription) SubscriptionView {
+	kinds := []string(m.Kinds)
+	if kinds == nil {
+		kinds = []string{}
+	}
+	return SubscriptionView{
+		ID:         int64(m.ID),
+		CreatedAt:  m.CreatedAt,
+		Actor:      m.Actor,
+		Kinds:      kinds,
+		Url:        m.Url,
+		LastHeight: m.LastHeight,
+	}
+}
+
+// CreatedSubscriptionView includes secret which is returned only when subscription is created
+type CreatedSubscriptionView struct {
+	SubscriptionView
+
+	Secret string `json:"secret"`
+}
+
+type SubscriptionListView struct {
+	Items []SubscriptionView `json:"items"`
+}
+
+func ToSubscriptionListView(subscriptions []model.WebhookSubscription) *SubscriptionListView {
+	items := make([]SubscriptionView, len(subscriptions))
+	for i, m := range subscriptions {
+		items[i] = ToSubscriptionView(m)
+	}
+	return &SubscriptionListView{
+		Items: items,
+	}
+}
+
+type DeliveryView struct {
+	ID             int64       `json:"id"`
+	CreatedAt      types.Time  `json:"created_at"`
+	SystemEventID  int64       `json:"system_event_id"`
+	Status         string      `json:"status"`
+	Attempts       int64       `json:"attempts"`
+	NextAttemptAt  *types.Time `json:"next_attempt_at"`
+	LastStatusCode *int64      `json:"last_status_code"`
+	LastError      *string     `json:"last_error"`
+	DeliveredAt    *types.Time `json:"delivered_at"`
+}
+
+type DeliveryListView struct {
+	Items []DeliveryView `json:"items"`
+}
+
+func ToDeliveryListView(deliveries []model.WebhookDelivery) *DeliveryListView {
+	items := make([]DeliveryView, len(deliveries))
+	for i, m := range deliveries {
+		items[i] = DeliveryView{
+			ID:             int64(m.ID),
+			CreatedAt:      m.CreatedAt,
+			SystemEventID:  m.SystemEventID,
+			Status:         m.Status.String(),
+			Attempts:       m.Attempts,
+			NextAttemptAt:  m.NextAttemptAt,
+			LastStatusCode: m.LastStatusCode,
+			LastError:      m.LastError,
+			DeliveredAt:    m.DeliveredAt,
+		}
+	}
+	return &DeliveryListView{
+		Items: items,
+	}
+}
+
+// EventPayload is body posted to webhook subscription url
+type EventPayload struct {
+	SubscriptionID int64       `json:"subscription_id"`
+	SystemEventID  int64       `json:"system_event_id"`
+	Height         int64       `json:"height"`
+	Time           types.Time  `json:"time"`
+	Actor          string      `json:"actor"`
+	Kind           string      `json:"kind"`
+	Data           types.Jsonb `json:"data"`
+}
+
+func ToEventPayload(subscriptionID int64, m model.SystemEvent) EventPayload {
+	return EventPayload{
+		SubscriptionID: subscriptionID,
+		SystemEventID:  int64(m.ID),
+		Height:         m.Height,
+		Time:           m.Time,
+		Actor:          m.Actor,
+		Kind:           m.Kind.String(),
+		Data:           m.Data,
+	}
+}
@@ -6,20 +6,25 @@ import (
 	"github.com/figment-networks/polkadothub-indexer/store"
 	"github.com/figment-networks/polkadothub-indexer/types"
 	"github.com/figment-networks/polkadothub-indexer/usecase/indexing"
+	"github.com/figment-networks/polkadothub-indexer/usecase/webhook"
 )
 
-func NewWorkerHandlers(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, reportDb store.Reports,
-	rewardDb store.Rewards, syncableDb store.Syncables, systemEventDb store.SystemEvents, transactionDb store.Transactions, validatorDb store.Validators,
+func NewWorkerHandlers(cfg *config.Config, cli *client.Client, accountDb store.Accounts, blockDb store.Blocks, databaseDb store.Database, eventDb store.Events, failedHeightDb store.FailedHeights, identityDb store.Identities, reportDb store.Reports,
+	rewardDb store.Rewards, slashDb store.Slashes, syncableDb store.Syncables, systemEventDb store.SystemEvents, targetRangeDb store.TargetRanges, transactionDb store.Transactions, validatorDb store.Validators, webhookDb store.Webhooks,
 ) *WorkerHandlers {
 	return &WorkerHandlers{
-		RunIndexer:       indexing.NewRunWorkerHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, reportDb, rewardDb, syncableDb, systemEventDb, transactionDb, validatorDb),
+		RunIndexer:       indexing.NewRunWorkerHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, failedHeightDb, identityDb, reportDb, rewardDb, slashDb, syncableDb, systemEventDb, targetRangeDb, transactionDb, validatorDb),
+		StreamIndexer:    indexing.NewStreamWorkerHandler(cfg, cli, accountDb, blockDb, databaseDb, eventDb, failedHeightDb, identityDb, reportDb, rewardDb, slashDb, syncableDb, systemEventDb, targetRangeDb, transactionDb, validatorDb),
 		SummarizeIndexer: indexing.NewSummarizeWorkerHandler(cfg, blockDb, validatorDb),
 		PurgeIndexer:     indexing.NewPurgeWorkerHandler(cfg, blockDb, validatorDb),
+		DeliverWebhooks:  webhook.NewDeliverWorkerHandler(cfg, syncableDb, systemEventDb, webhookDb),
 	}
 }
 
 type WorkerHandlers struct {
 	RunIndexer       types.WorkerHandler
+	StreamIndexer    types.WorkerHandler
 	SummarizeIndexer types.WorkerHandler
 	PurgeIndexer     types.WorkerHandler
+	DeliverWebhooks  types.WorkerHandler
 }
@@ -1,6 +1,15 @@
 package worker
 
-import "github.com/robfig/cron/v3"
+import (
+	"time"
+
+	"github.com/figment-networks/polkadothub-indexer/utils/logger"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	streamRestartInterval = time.Minute
+)
 
 func (w *Worker) addRunIndexerJob() (cron.EntryID, error) {
 	job = cron.FuncJob(w.handlers.RunIndexer.Handle)
@@ -8,6 +17,16 @@ func (w *Worker) addRunIndexerJob() (cron.EntryID, error) {
 	return w.cronJob.AddJob(w.cfg.IndexWorkerInterval, job)
 }
 
+// runStreamIndexer keeps streaming indexer running, restarting it when it stops
+func (w *Worker) runStreamIndexer() {
+	for {
+		w.handlers.StreamIndexer.Handle()
+
+		logger.Info("stream indexer stopped, restarting ...", logger.Field("app", "worker"))
+		time.Sleep(streamRestartInterval)
+	}
+}
+
 func (w *Worker) addSummarizeIndexerJob() (cron.EntryID, error) {
 	job = cron.FuncJob(w.handlers.SummarizeIndexer.Handle)
 	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
@@ -19,3 +38,9 @@ func (w *Worker) addPurgeIndexerJob() (cron.EntryID, error) {
 	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
 	return w.cronJob.AddJob(w.cfg.PurgeWorkerInterval, job)
 }
+
+func (w *Worker) addDeliverWebhooksJob() (cron.EntryID, error) {
+	job = cron.FuncJob(w.handlers.DeliverWebhooks.Handle)
+	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
+	return w.cronJob.AddJob(w.cfg.WebhookWorkerInterval, job)
+}
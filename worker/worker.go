@@ -41,12 +41,15 @@ func New(cfg *config.Config, handlers *usecase.WorkerHandlers) (*Worker, error)
 }
 
 func (w *Worker) init() (*Worker, error) {
-	_, err := w.addRunIndexerJob()
-	if err != nil {
-		return nil, err
+	// Streaming indexer replaces cron driven indexing
+	if !w.cfg.IndexWorkerStream {
+		_, err := w.addRunIndexerJob()
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	_, err = w.addSummarizeIndexerJob()
+	_, err := w.addSummarizeIndexerJob()
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +59,14 @@ func (w *Worker) init() (*Worker, error) {
 		return nil, err
 	}
 
+	// Webhook delivery is disabled when interval is empty
+	if w.cfg.WebhookWorkerInterval != "" {
+		_, err = w.addDeliverWebhooksJob()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return w, nil
 }
 
@@ -66,6 +77,10 @@ func (w *Worker) Start() error {
 
 	w.cronJob.Start()
 
+	if w.cfg.IndexWorkerStream {
+		go w.runStreamIndexer()
+	}
+
 	return w.startMetricsServer()
 }
 